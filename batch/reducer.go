@@ -0,0 +1,191 @@
+package batch
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/metaphi-labs/latent-contracts/errors"
+	"github.com/metaphi-labs/latent-contracts/messages"
+	"github.com/metaphi-labs/latent-contracts/progress"
+	"github.com/metaphi-labs/latent-contracts/results"
+)
+
+// Reducer folds the outcomes of a batch's items into progress.BatchUpdate messages
+// and the final messages.BatchResult. Each method applies one item event and returns
+// the update to publish. It is safe for concurrent use by the goroutines running items.
+type Reducer struct {
+	mu      sync.Mutex
+	jobID   string
+	call    *messages.BatchToolCall
+	index   map[string]int // Item ID -> position
+	items   []progress.ItemStatus
+	results []messages.BatchItemResult
+	current string
+}
+
+// NewReducer creates a reducer with every item of the call queued
+func NewReducer(jobID string, call *messages.BatchToolCall) *Reducer {
+	r := &Reducer{jobID: jobID, call: call, index: make(map[string]int, len(call.Items))}
+	for i, item := range call.Items {
+		r.index[item.ID] = i
+		r.items = append(r.items, progress.ItemStatus{ID: item.ID, Status: progress.StatusQueued})
+		r.results = append(r.results, messages.BatchItemResult{ID: item.ID})
+	}
+	return r
+}
+
+// Start marks an item as processing
+func (r *Reducer) Start(itemID string) (*progress.BatchUpdate, error) {
+	return r.apply(itemID, func(i int) {
+		r.items[i].Status = progress.StatusProcessing
+		r.current = itemID
+	})
+}
+
+// Progress records an item's own progress (0-100)
+func (r *Reducer) Progress(itemID string, percent int) (*progress.BatchUpdate, error) {
+	return r.apply(itemID, func(i int) {
+		if percent < 0 {
+			percent = 0
+		}
+		if percent > 100 {
+			percent = 100
+		}
+		r.items[i].Status = progress.StatusProcessing
+		r.items[i].Progress = percent
+		r.current = itemID
+	})
+}
+
+// Complete records an item's result; a result with Success false fails the item
+func (r *Reducer) Complete(itemID string, result *results.ToolResult) (*progress.BatchUpdate, error) {
+	if result == nil {
+		return nil, fmt.Errorf("item %s: result is required", itemID)
+	}
+	return r.apply(itemID, func(i int) {
+		r.results[i].Result = result
+		if !result.Success {
+			err := result.Error
+			if err == nil {
+				err = messages.UnreportedFailure(result.Tool)
+			}
+			r.results[i].Error = err
+			r.failItem(i, err)
+			return
+		}
+		r.items[i].Status = progress.StatusCompleted
+		r.items[i].Progress = 100
+		r.items[i].Result = result
+		if r.current == itemID {
+			r.current = ""
+		}
+	})
+}
+
+// Fail records an item that failed without a result
+func (r *Reducer) Fail(itemID string, err *errors.ServiceError) (*progress.BatchUpdate, error) {
+	if err == nil {
+		err = messages.UnreportedFailure(r.call.Name)
+	}
+	return r.apply(itemID, func(i int) {
+		r.results[i].Error = err
+		r.failItem(i, err)
+	})
+}
+
+// Cancel marks every item that hasn't finished as cancelled
+func (r *Reducer) Cancel(reason string) *progress.BatchUpdate {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := range r.items {
+		if r.items[i].Status.IsTerminal() {
+			continue
+		}
+		r.items[i].Status = progress.StatusCancelled
+		r.items[i].Progress = 100
+		r.items[i].Error = &reason
+		r.results[i].Error = errors.NewServiceError(errors.TOOL_EXECUTION_FAILED, reason, "batch", false)
+	}
+	r.current = ""
+	return r.snapshot()
+}
+
+// Update returns the current state without applying an event
+func (r *Reducer) Update() *progress.BatchUpdate {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.snapshot()
+}
+
+// Done reports whether every item has finished
+func (r *Reducer) Done() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, item := range r.items {
+		if !item.Status.IsTerminal() {
+			return false
+		}
+	}
+	return true
+}
+
+// Result returns the batch result so far, items in request order
+func (r *Reducer) Result() *messages.BatchResult {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return &messages.BatchResult{
+		Ref:   r.call.Ref,
+		Tool:  r.call.Name,
+		JobID: r.jobID,
+		Items: append([]messages.BatchItemResult(nil), r.results...),
+	}
+}
+
+func (r *Reducer) apply(itemID string, event func(i int)) (*progress.BatchUpdate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	i, exists := r.index[itemID]
+	if !exists {
+		return nil, fmt.Errorf("unknown batch item: %s", itemID)
+	}
+	if r.items[i].Status.IsTerminal() {
+		return nil, fmt.Errorf("item %s already finished as %s", itemID, r.items[i].Status)
+	}
+	event(i)
+	return r.snapshot(), nil
+}
+
+func (r *Reducer) failItem(i int, err *errors.ServiceError) {
+	message := err.Message
+	r.items[i].Status = progress.StatusFailed
+	r.items[i].Progress = 100
+	r.items[i].Error = &message
+	if r.current == r.items[i].ID {
+		r.current = ""
+	}
+}
+
+// snapshot builds an update from the current state. Overall progress averages the
+// items' progress; finished items, failed or cancelled included, count as 100.
+func (r *Reducer) snapshot() *progress.BatchUpdate {
+	update := progress.NewBatchUpdate(r.jobID, r.call.Name, len(r.items), 0, 0)
+	update.CurrentItem = r.current
+	update.ItemStatuses = append([]progress.ItemStatus(nil), r.items...)
+
+	total := 0
+	for _, item := range r.items {
+		switch item.Status {
+		case progress.StatusCompleted:
+			update.CompletedItems++
+		case progress.StatusFailed, progress.StatusCancelled:
+			update.FailedItems++
+		}
+		total += item.Progress
+	}
+	if len(r.items) > 0 {
+		update.Progress = total / len(r.items)
+	}
+	update.UpdatedAt = time.Now()
+	return update
+}
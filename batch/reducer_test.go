@@ -0,0 +1,46 @@
+package batch
+
+import (
+	"testing"
+
+	"github.com/metaphi-labs/latent-contracts/errors"
+	"github.com/metaphi-labs/latent-contracts/messages"
+	"github.com/metaphi-labs/latent-contracts/results"
+)
+
+func TestFailedResultWithoutErrorFailsItem(t *testing.T) {
+	call := &messages.BatchToolCall{
+		Ref:   "ref",
+		Name:  "generate-image-imagen",
+		Items: []messages.BatchItem{{ID: "a"}, {ID: "b"}},
+	}
+	r := NewReducer("job", call)
+
+	if _, err := r.Complete("a", &results.ToolResult{Success: true, Tool: call.Name}); err != nil {
+		t.Fatal(err)
+	}
+	update, err := r.Complete("b", &results.ToolResult{Success: false, Tool: call.Name})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if update.FailedItems != 1 {
+		t.Errorf("FailedItems = %d, want 1", update.FailedItems)
+	}
+
+	result := r.Result()
+	if item := result.Items[1]; item.Error == nil || item.Error.Code != errors.TOOL_EXECUTION_FAILED {
+		t.Errorf("item error = %v, want %s", item.Error, errors.TOOL_EXECUTION_FAILED)
+	}
+	if succeeded, failed := result.Counts(); succeeded != 1 || failed != 1 {
+		t.Errorf("Counts() = %d, %d, want 1, 1", succeeded, failed)
+	}
+}
+
+func TestCountsFailedResultWithoutError(t *testing.T) {
+	result := messages.BatchResult{Items: []messages.BatchItemResult{
+		{ID: "a", Result: &results.ToolResult{Success: false, Tool: "merge-images"}},
+	}}
+	if _, failed := result.Counts(); failed != 1 {
+		t.Errorf("failed = %d, want 1", failed)
+	}
+}
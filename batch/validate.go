@@ -0,0 +1,94 @@
+// Package batch validates and prices messages.BatchToolCall requests and reduces
+// the outcomes of their items into progress.BatchUpdate messages.
+package batch
+
+import (
+	"fmt"
+
+	"github.com/metaphi-labs/latent-contracts/errors"
+	"github.com/metaphi-labs/latent-contracts/messages"
+	"github.com/metaphi-labs/latent-contracts/pricing"
+	"github.com/metaphi-labs/latent-contracts/tools"
+)
+
+// ItemPlan is the validation outcome of one item
+type ItemPlan struct {
+	ID       string               `json:"id"`
+	Estimate *pricing.Estimate    `json:"estimate,omitempty"` // Nil when the item is invalid
+	Error    *errors.ServiceError `json:"error,omitempty"`
+}
+
+// Plan is a validated batch with its aggregate credit estimate
+type Plan struct {
+	Tool    tools.ToolName `json:"tool"` // Canonical name; aliases are resolved
+	Items   []ItemPlan     `json:"items"`
+	Credits int            `json:"credits"` // Sum over valid items
+}
+
+// ValidItems returns the IDs of the items that can run
+func (p *Plan) ValidItems() []string {
+	var ids []string
+	for _, item := range p.Items {
+		if item.Error == nil {
+			ids = append(ids, item.ID)
+		}
+	}
+	return ids
+}
+
+// Validate checks a batch call and every item's merged parameters, and estimates
+// each valid item. Item failures are reported together as a VAL_INVALID_REQUEST
+// ServiceError with fields like "items[3].prompt"; the plan is returned alongside
+// so callers can run the valid items and reject the rest.
+func Validate(call *messages.BatchToolCall) (*Plan, error) {
+	if err := call.Validate(); err != nil {
+		return nil, errors.NewServiceError(errors.VAL_INVALID_REQUEST, err.Error(), "batch", false)
+	}
+	name, _, exists := tools.ResolveToolName(call.Name)
+	if !exists {
+		return nil, errors.NewServiceError(errors.TOOL_NOT_FOUND, fmt.Sprintf("Unknown tool '%s'", call.Name), "batch", false)
+	}
+
+	plan := &Plan{Tool: name}
+	var details []errors.ValidationDetail
+	for i, item := range call.Items {
+		itemPlan := ItemPlan{ID: item.ID}
+		estimate, err := estimateItem(name, call.ItemCall(i))
+		if err != nil {
+			itemPlan.Error = err
+			for _, detail := range itemDetails(err) {
+				detail.Field = fmt.Sprintf("items[%d].%s", i, detail.Field)
+				details = append(details, detail)
+			}
+		} else {
+			itemPlan.Estimate = estimate
+			plan.Credits += estimate.Credits
+		}
+		plan.Items = append(plan.Items, itemPlan)
+	}
+
+	if len(details) > 0 {
+		return plan, errors.ValidationError("batch", details)
+	}
+	return plan, nil
+}
+
+func estimateItem(name tools.ToolName, call messages.ToolCall) (*pricing.Estimate, *errors.ServiceError) {
+	decoded, err := tools.DecodeParams(string(name), call.Parameters)
+	if err != nil {
+		return nil, tools.ValidationErrorToServiceError(err, string(name), "")
+	}
+	estimate, err := pricing.EstimateCall(name, decoded)
+	if err != nil {
+		return nil, errors.NewServiceError(errors.TOOL_INVALID_PARAMS, err.Error(), "batch", false).WithCause(err)
+	}
+	return estimate, nil
+}
+
+// itemDetails returns an item error's validation details, or one detail summarising it
+func itemDetails(err *errors.ServiceError) []errors.ValidationDetail {
+	if err.HasValidationErrors() {
+		return err.Metadata.ValidationDetails
+	}
+	return []errors.ValidationDetail{{Field: "parameters", Reason: err.Message, Code: err.Code}}
+}
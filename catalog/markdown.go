@@ -0,0 +1,199 @@
+package catalog
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/metaphi-labs/latent-contracts/tools"
+)
+
+// Markdown renders a catalog of every registered tool: a summary table followed by
+// a section per tool with its endpoint, parameters and examples
+func Markdown() (string, error) {
+	var b strings.Builder
+	b.WriteString("# Tool Catalog\n\n")
+	b.WriteString("Generated from the tool registry; do not edit by hand.\n\n")
+	b.WriteString("| Tool | Service | Output | Credits | Status |\n")
+	b.WriteString("|------|---------|--------|---------|--------|\n")
+
+	names := tools.RegisteredTools()
+	for _, name := range names {
+		meta, _ := tools.GetToolMetadata(name)
+		fmt.Fprintf(&b, "| [`%s`](#%s) | %s | %s | %d | %s |\n",
+			name, anchor(string(name)), meta.ServiceType, meta.OutputType, meta.Credits, status(meta))
+	}
+
+	for _, name := range names {
+		if err := writeTool(&b, name); err != nil {
+			return "", err
+		}
+	}
+	return b.String(), nil
+}
+
+func writeTool(b *strings.Builder, name tools.ToolName) error {
+	meta, _ := tools.GetToolMetadata(name)
+	schema, err := tools.GetJSONSchema(name)
+	if err != nil {
+		return fmt.Errorf("tool %s: %w", name, err)
+	}
+	schema, err = tools.InlineSchemaRefs(schema)
+	if err != nil {
+		return fmt.Errorf("tool %s: %w", name, err)
+	}
+
+	fmt.Fprintf(b, "\n## %s\n\n%s\n\n", name, meta.Description)
+	if tools.IsDeprecated(name) {
+		b.WriteString("> **Deprecated.**")
+		if meta.Successor != "" {
+			fmt.Fprintf(b, " Use [`%s`](#%s) instead.", meta.Successor, anchor(string(meta.Successor)))
+		}
+		b.WriteString("\n\n")
+	}
+
+	if meta.EndpointPath != "" {
+		fmt.Fprintf(b, "- Endpoint: `POST %s`\n", meta.EndpointPath)
+	}
+	fmt.Fprintf(b, "- Service: %s\n- Output: %s\n- Credits: %d\n", meta.ServiceType, meta.OutputType, meta.Credits)
+	if meta.Version != "" {
+		fmt.Fprintf(b, "- Version: %s\n", meta.Version)
+	}
+	if meta.Availability.Restricted() {
+		fmt.Fprintf(b, "- Availability: %s\n", meta.Availability.Describe())
+	}
+	if meta.RateLimit.Limited() {
+		fmt.Fprintf(b, "- Rate limits: %s\n", meta.RateLimit.Describe())
+	}
+	if meta.Duration.Typical > 0 {
+		fmt.Fprintf(b, "- Typical duration: %s (p95 %s)\n", meta.Duration.Typical, meta.Duration.P95)
+	}
+	fmt.Fprintf(b, "- Timeout: %s\n", meta.Duration.TimeoutOrDefault())
+
+	props, _ := schema["properties"].(map[string]interface{})
+	if len(props) > 0 {
+		b.WriteString("\n| Parameter | Type | Required | Default | Constraints | Description |\n")
+		b.WriteString("|-----------|------|----------|---------|-------------|-------------|\n")
+		required := stringSet(schema["required"])
+		for _, field := range propertyOrder(props, required) {
+			prop, _ := props[field].(map[string]interface{})
+			def := ""
+			if value, ok := prop["default"]; ok {
+				def = fmt.Sprintf("`%v`", value)
+			}
+			req := ""
+			if required[field] {
+				req = "yes"
+			}
+			desc, _ := prop["description"].(string)
+			fmt.Fprintf(b, "| `%s` | %s | %s | %s | %s | %s |\n",
+				field, typeName(prop), req, def, cell(constraints(prop)), cell(desc))
+		}
+	}
+
+	if len(meta.Examples) > 0 {
+		b.WriteString("\nExamples:\n\n")
+		for _, example := range meta.Examples {
+			fmt.Fprintf(b, "- %s\n", example)
+		}
+	}
+	return nil
+}
+
+// propertyOrder lists required parameters first, then the rest, each alphabetically
+func propertyOrder(props map[string]interface{}, required map[string]bool) []string {
+	names := make([]string, 0, len(props))
+	for name := range props {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if required[names[i]] != required[names[j]] {
+			return required[names[i]]
+		}
+		return names[i] < names[j]
+	})
+	return names
+}
+
+// typeName describes a property's JSON type, e.g. "array of object"
+func typeName(prop map[string]interface{}) string {
+	switch t := prop["type"].(type) {
+	case string:
+		if t == "array" {
+			if items, ok := prop["items"].(map[string]interface{}); ok {
+				return "array of " + typeName(items)
+			}
+		}
+		return t
+	case []interface{}:
+		parts := make([]string, len(t))
+		for i, part := range t {
+			parts[i] = fmt.Sprint(part)
+		}
+		return strings.Join(parts, " or ")
+	}
+	if _, ok := prop["properties"]; ok {
+		return "object"
+	}
+	return "any"
+}
+
+// constraints summarizes the validation keywords of a property
+func constraints(prop map[string]interface{}) string {
+	var parts []string
+	if enum, ok := prop["enum"].([]interface{}); ok {
+		values := make([]string, len(enum))
+		for i, v := range enum {
+			values[i] = fmt.Sprintf("`%v`", v)
+		}
+		parts = append(parts, "one of "+strings.Join(values, ", "))
+	}
+	for _, bound := range []struct{ keyword, label string }{
+		{"minimum", "≥"}, {"exclusiveMinimum", ">"}, {"maximum", "≤"}, {"exclusiveMaximum", "<"},
+		{"minLength", "min length"}, {"maxLength", "max length"},
+		{"minItems", "min items"}, {"maxItems", "max items"},
+	} {
+		if value, ok := prop[bound.keyword]; ok {
+			parts = append(parts, fmt.Sprintf("%s %v", bound.label, value))
+		}
+	}
+	if format, ok := prop["format"].(string); ok {
+		parts = append(parts, format)
+	}
+	return strings.Join(parts, "; ")
+}
+
+func status(meta tools.ToolMeta) string {
+	if tools.IsDeprecated(meta.Name) {
+		return "deprecated"
+	}
+	return "active"
+}
+
+// anchor is the heading anchor GitHub generates for a tool name
+func anchor(s string) string {
+	return strings.ToLower(s)
+}
+
+// cell escapes text for a Markdown table cell
+func cell(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	return strings.ReplaceAll(s, "\n", " ")
+}
+
+func stringSet(list interface{}) map[string]bool {
+	set := make(map[string]bool)
+	switch l := list.(type) {
+	case []interface{}:
+		for _, v := range l {
+			if s, ok := v.(string); ok {
+				set[s] = true
+			}
+		}
+	case []string:
+		for _, s := range l {
+			set[s] = true
+		}
+	}
+	return set
+}
@@ -0,0 +1,352 @@
+// Package catalog generates API descriptions of the tool registry: an OpenAPI 3.1
+// document for the async tool endpoints and a Markdown catalog of the tools.
+package catalog
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/invopop/jsonschema"
+
+	"github.com/metaphi-labs/latent-contracts/callbacks"
+	"github.com/metaphi-labs/latent-contracts/errors"
+	"github.com/metaphi-labs/latent-contracts/messages"
+	"github.com/metaphi-labs/latent-contracts/tools"
+)
+
+// OpenAPIVersion is the OpenAPI version of generated documents
+const OpenAPIVersion = "3.1.0"
+
+// Component names shared by every operation
+const (
+	schemaAccepted     = "JobAccepted"
+	schemaCallback     = "CallbackRequest"
+	schemaServiceError = "ServiceError"
+	responseInvalid    = "InvalidParams"
+	responseError      = "ServiceError"
+	webhookToolResult  = "toolResult"
+)
+
+// Options describes the API in the generated document
+type Options struct {
+	Title   string
+	Version string
+	Servers []string // Base URLs; omitted from the document when empty
+}
+
+// OpenAPI builds an OpenAPI 3.1 document with one POST operation per registered tool
+// that has an endpoint. Request bodies are the tools' params schemas; the async 202
+// response, the callback webhook and the ServiceError responses are shared components.
+func OpenAPI(opts Options) (map[string]interface{}, error) {
+	c := newComponents()
+	for name, t := range map[string]reflect.Type{
+		schemaAccepted:     reflect.TypeOf(messages.JobAccepted{}),
+		schemaCallback:     reflect.TypeOf(callbacks.CallbackRequest{}),
+		schemaServiceError: reflect.TypeOf(errors.ServiceError{}),
+	} {
+		if err := c.addType(name, t); err != nil {
+			return nil, err
+		}
+	}
+
+	paths := make(map[string]interface{})
+	var tags []interface{}
+	seenTags := make(map[string]bool)
+	for _, name := range tools.RegisteredTools() {
+		meta, _ := tools.GetToolMetadata(name)
+		if meta.EndpointPath == "" {
+			continue // not served over HTTP
+		}
+		if _, exists := paths[meta.EndpointPath]; exists {
+			return nil, fmt.Errorf("tool %s: endpoint %s is already used by another tool", name, meta.EndpointPath)
+		}
+
+		schema, err := tools.GetJSONSchema(name)
+		if err != nil {
+			return nil, fmt.Errorf("tool %s: %w", name, err)
+		}
+		bodyRef, err := c.addSchema(schema)
+		if err != nil {
+			return nil, fmt.Errorf("tool %s: %w", name, err)
+		}
+
+		tag := string(meta.ServiceType)
+		if !seenTags[tag] {
+			seenTags[tag] = true
+			tags = append(tags, map[string]interface{}{"name": tag})
+		}
+		paths[meta.EndpointPath] = map[string]interface{}{
+			"post": operation(meta, bodyRef),
+		}
+	}
+
+	info := map[string]interface{}{
+		"title":   opts.Title,
+		"version": opts.Version,
+	}
+	if opts.Title == "" {
+		info["title"] = "Latent tool API"
+	}
+	if opts.Version == "" {
+		info["version"] = "0.0.0"
+	}
+
+	doc := map[string]interface{}{
+		"openapi": OpenAPIVersion,
+		"info":    info,
+		"tags":    tags,
+		"paths":   paths,
+		"webhooks": map[string]interface{}{
+			webhookToolResult: map[string]interface{}{
+				"post": map[string]interface{}{
+					"summary":     "Tool result callback",
+					"description": "Sent by the executing service to Platform API when a job completes, fails or delivers a partial result.",
+					"requestBody": jsonBody(componentRef(schemaCallback), true),
+					"responses": map[string]interface{}{
+						"200": map[string]interface{}{"description": "Callback received"},
+					},
+				},
+			},
+		},
+		"components": map[string]interface{}{
+			"schemas": c.schemas,
+			"responses": map[string]interface{}{
+				responseInvalid: map[string]interface{}{
+					"description": "The params failed validation; metadata.validation_details lists every problem",
+					"content":     jsonContent(componentRef(schemaServiceError)),
+				},
+				responseError: map[string]interface{}{
+					"description": "The request could not be accepted",
+					"content":     jsonContent(componentRef(schemaServiceError)),
+				},
+			},
+		},
+	}
+	if len(opts.Servers) > 0 {
+		servers := make([]interface{}, len(opts.Servers))
+		for i, url := range opts.Servers {
+			servers[i] = map[string]interface{}{"url": url}
+		}
+		doc["servers"] = servers
+	}
+	return doc, nil
+}
+
+// OpenAPIJSON is OpenAPI marshalled as indented JSON
+func OpenAPIJSON(opts Options) ([]byte, error) {
+	doc, err := OpenAPI(opts)
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+// operation describes one tool endpoint
+func operation(meta tools.ToolMeta, bodyRef map[string]interface{}) map[string]interface{} {
+	op := map[string]interface{}{
+		"operationId": string(meta.Name),
+		"summary":     summary(meta.Description),
+		"description": meta.Description,
+		"tags":        []string{string(meta.ServiceType)},
+		"requestBody": jsonBody(bodyRef, true),
+		"responses": map[string]interface{}{
+			"202": map[string]interface{}{
+				"description": "Job accepted; the result is delivered to the toolResult webhook",
+				"content":     jsonContent(componentRef(schemaAccepted)),
+			},
+			"400":     map[string]interface{}{"$ref": "#/components/responses/" + responseInvalid},
+			"default": map[string]interface{}{"$ref": "#/components/responses/" + responseError},
+		},
+		"x-credits":     meta.Credits,
+		"x-output-type": string(meta.OutputType),
+	}
+	if meta.Availability.Restricted() {
+		op["x-availability"] = availability(meta.Availability)
+	}
+	if meta.RateLimit.Limited() {
+		op["x-rate-limit"] = rateLimit(meta.Name, meta.RateLimit)
+	}
+	op["x-duration"] = duration(meta.Duration)
+	if tools.IsDeprecated(meta.Name) {
+		op["deprecated"] = true
+		if meta.Successor != "" {
+			op["x-successor"] = string(meta.Successor)
+		}
+	}
+	return op
+}
+
+// availability lists the rules that are set; calls outside them get 403 AUTH_FORBIDDEN
+func availability(a tools.Availability) map[string]interface{} {
+	rules := make(map[string]interface{})
+	if a.MinTier != "" {
+		rules["min_tier"] = string(a.MinTier)
+	}
+	if len(a.Regions) > 0 {
+		rules["regions"] = a.Regions
+	}
+	if len(a.BlockedRegions) > 0 {
+		rules["blocked_regions"] = a.BlockedRegions
+	}
+	if a.Flag != "" {
+		rules["flag"] = a.Flag
+	}
+	if len(a.Orgs) > 0 {
+		rules["orgs"] = a.Orgs
+	}
+	return rules
+}
+
+// rateLimit lists the limits that are set; calls over them get 429 RATE_LIMIT_EXCEEDED
+func rateLimit(tool tools.ToolName, p tools.RateLimitPolicy) map[string]interface{} {
+	limits := map[string]interface{}{"group": p.Key(tool)}
+	if p.UserRPM > 0 {
+		limits["user_rpm"] = p.UserRPM
+	}
+	if p.UserConcurrent > 0 {
+		limits["user_concurrent"] = p.UserConcurrent
+	}
+	if p.GlobalConcurrent > 0 {
+		limits["global_concurrent"] = p.GlobalConcurrent
+	}
+	return limits
+}
+
+// duration gives expected run times in seconds; jobs past the timeout fail with TOOL_TIMEOUT
+func duration(d tools.ExpectedDuration) map[string]interface{} {
+	durations := map[string]interface{}{"timeout_seconds": d.TimeoutOrDefault().Seconds()}
+	if d.Typical > 0 {
+		durations["typical_seconds"] = d.Typical.Seconds()
+	}
+	if d.P95 > 0 {
+		durations["p95_seconds"] = d.P95.Seconds()
+	}
+	return durations
+}
+
+// summary is the first sentence of a description
+func summary(description string) string {
+	if i := strings.Index(description, ". "); i != -1 {
+		return description[:i]
+	}
+	return strings.TrimSuffix(description, ".")
+}
+
+func jsonBody(schema map[string]interface{}, required bool) map[string]interface{} {
+	return map[string]interface{}{
+		"required": required,
+		"content":  jsonContent(schema),
+	}
+}
+
+func jsonContent(schema map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"application/json": map[string]interface{}{"schema": schema},
+	}
+}
+
+func componentRef(name string) map[string]interface{} {
+	return map[string]interface{}{"$ref": "#/components/schemas/" + name}
+}
+
+// components collects schemas from several JSON schema documents into one
+// components.schemas section, rewriting their $defs references
+type components struct {
+	schemas map[string]interface{}
+}
+
+func newComponents() *components {
+	return &components{schemas: make(map[string]interface{})}
+}
+
+// addType reflects a Go type and adds it under name
+func (c *components) addType(name string, t reflect.Type) error {
+	reflector := &jsonschema.Reflector{AllowAdditionalProperties: true}
+	raw, err := json.Marshal(reflector.ReflectFromType(t))
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s schema: %w", name, err)
+	}
+	var schema map[string]interface{}
+	if err := json.Unmarshal(raw, &schema); err != nil {
+		return fmt.Errorf("failed to unmarshal %s schema: %w", name, err)
+	}
+
+	ref, err := c.addSchema(schema)
+	if err != nil {
+		return err
+	}
+	if ref["$ref"] != "#/components/schemas/"+name {
+		return fmt.Errorf("%s schema was reflected as %v", name, ref["$ref"])
+	}
+	return nil
+}
+
+// addSchema hoists a schema's $defs into the components and returns a reference to its root.
+// Tools share nested types whose schemas can differ per tool (media constraints, for
+// example); a def that clashes with an existing component is prefixed with the root type name.
+func (c *components) addSchema(schema map[string]interface{}) (map[string]interface{}, error) {
+	ref, ok := schema["$ref"].(string)
+	if !ok || !strings.HasPrefix(ref, "#/$defs/") {
+		return nil, fmt.Errorf("schema has no root $ref")
+	}
+	root := strings.TrimPrefix(ref, "#/$defs/")
+	defs, _ := schema["$defs"].(map[string]interface{})
+
+	names := make(map[string]string, len(defs))
+	for name := range defs {
+		names[name] = name
+	}
+	// Renaming one def changes the refs of those that use it, so repeat until stable
+	for changed := true; changed; {
+		changed = false
+		for name, def := range defs {
+			if names[name] != name {
+				continue
+			}
+			existing, taken := c.schemas[name]
+			if taken && !reflect.DeepEqual(existing, rewriteRefs(def, names)) {
+				names[name] = root + "_" + name
+				changed = true
+			}
+		}
+	}
+
+	for name, def := range defs {
+		target := names[name]
+		def = rewriteRefs(def, names)
+		if existing, taken := c.schemas[target]; taken && !reflect.DeepEqual(existing, def) {
+			return nil, fmt.Errorf("two different schemas are named %s", target)
+		}
+		c.schemas[target] = def
+	}
+	return map[string]interface{}{"$ref": "#/components/schemas/" + names[root]}, nil
+}
+
+// rewriteRefs points $defs references at components.schemas, using the final component names
+func rewriteRefs(node interface{}, names map[string]string) interface{} {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(n))
+		for key, value := range n {
+			if ref, ok := value.(string); ok && key == "$ref" && strings.HasPrefix(ref, "#/$defs/") {
+				name := strings.TrimPrefix(ref, "#/$defs/")
+				if renamed, ok := names[name]; ok {
+					name = renamed
+				}
+				out[key] = "#/components/schemas/" + name
+				continue
+			}
+			out[key] = rewriteRefs(value, names)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(n))
+		for i, item := range n {
+			out[i] = rewriteRefs(item, names)
+		}
+		return out
+	}
+	return node
+}
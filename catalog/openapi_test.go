@@ -0,0 +1,98 @@
+package catalog
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/metaphi-labs/latent-contracts/tools"
+)
+
+// openAPIDoc builds the document and decodes it back as plain JSON
+func openAPIDoc(t *testing.T) map[string]interface{} {
+	t.Helper()
+	raw, err := OpenAPIJSON(Options{Title: "Test API", Servers: []string{"https://api.example.com"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var doc map[string]interface{}
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		t.Fatal(err)
+	}
+	return doc
+}
+
+// lookup follows a path of object keys through decoded JSON
+func lookup(node interface{}, keys ...string) interface{} {
+	for _, key := range keys {
+		obj, _ := node.(map[string]interface{})
+		node = obj[key]
+	}
+	return node
+}
+
+func TestOpenAPIDocument(t *testing.T) {
+	doc := openAPIDoc(t)
+	if doc["openapi"] != "3.1.0" {
+		t.Errorf("openapi = %v, want 3.1.0", doc["openapi"])
+	}
+	if lookup(doc, "info", "title") != "Test API" {
+		t.Errorf("info = %v", doc["info"])
+	}
+	for _, name := range []string{"JobAccepted", "CallbackRequest", "ServiceError"} {
+		if lookup(doc, "components", "schemas", name) == nil {
+			t.Errorf("components.schemas.%s is missing", name)
+		}
+	}
+
+	callback := lookup(doc, "webhooks", "toolResult", "post", "requestBody", "content", "application/json", "schema", "$ref")
+	if callback != "#/components/schemas/CallbackRequest" {
+		t.Errorf("toolResult webhook body = %v", callback)
+	}
+}
+
+func TestOpenAPIPathPerTool(t *testing.T) {
+	doc := openAPIDoc(t)
+	paths, _ := doc["paths"].(map[string]interface{})
+
+	served := 0
+	for _, name := range tools.RegisteredTools() {
+		meta, _ := tools.GetToolMetadata(name)
+		if meta.EndpointPath == "" {
+			continue
+		}
+		served++
+
+		op := lookup(paths, meta.EndpointPath, "post")
+		if op == nil {
+			t.Errorf("%s: no POST %s", name, meta.EndpointPath)
+			continue
+		}
+		if lookup(op, "operationId") != string(name) {
+			t.Errorf("%s: operationId = %v", name, lookup(op, "operationId"))
+		}
+		accepted := lookup(op, "responses", "202", "content", "application/json", "schema", "$ref")
+		if accepted != "#/components/schemas/JobAccepted" {
+			t.Errorf("%s: 202 response = %v", name, accepted)
+		}
+		body, _ := lookup(op, "requestBody", "content", "application/json", "schema", "$ref").(string)
+		if !strings.HasPrefix(body, "#/components/schemas/") || lookup(doc, "components", "schemas", strings.TrimPrefix(body, "#/components/schemas/")) == nil {
+			t.Errorf("%s: request body %q does not resolve", name, body)
+		}
+	}
+	if served == 0 || len(paths) != served {
+		t.Errorf("%d paths for %d tools with endpoints", len(paths), served)
+	}
+}
+
+func TestMarkdownListsTools(t *testing.T) {
+	md, err := Markdown()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range tools.RegisteredTools() {
+		if !strings.Contains(md, string(name)) {
+			t.Errorf("catalog does not mention %s", name)
+		}
+	}
+}
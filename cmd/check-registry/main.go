@@ -0,0 +1,29 @@
+// Command check-registry reports drift between the tool registry and the params structs
+// it describes: signable fields, metadata, JSON schemas, validator tags and endpoints.
+//
+// Usage:
+//
+//	go run ./cmd/check-registry
+//
+// Exits non-zero when any issue is found, so it can gate CI.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/metaphi-labs/latent-contracts/tools"
+)
+
+func main() {
+	issues := tools.CheckRegistry()
+	for _, issue := range issues {
+		fmt.Println(issue)
+	}
+
+	if len(issues) > 0 {
+		fmt.Fprintf(os.Stderr, "%d registry issue(s) found\n", len(issues))
+		os.Exit(1)
+	}
+	fmt.Printf("%d tools checked, no issues found\n", len(tools.RegisteredTools()))
+}
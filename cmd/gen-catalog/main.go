@@ -0,0 +1,61 @@
+// Command gen-catalog writes the OpenAPI 3.1 document for the async tool endpoints
+// or a Markdown catalog of the tools.
+//
+// Usage:
+//
+//	go run ./cmd/gen-catalog -format openapi -version v1.4.0 -server https://media-ai.example.com > openapi.json
+//	go run ./cmd/gen-catalog -format markdown -o TOOLS.md
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/metaphi-labs/latent-contracts/catalog"
+)
+
+func main() {
+	format := flag.String("format", "openapi", "output format: openapi or markdown")
+	out := flag.String("o", "", "output file (default stdout)")
+	title := flag.String("title", "", "API title for the OpenAPI document")
+	version := flag.String("version", "", "API version for the OpenAPI document")
+	servers := flag.String("server", "", "comma-separated server URLs for the OpenAPI document")
+	flag.Parse()
+
+	var data []byte
+	switch *format {
+	case "openapi":
+		opts := catalog.Options{Title: *title, Version: *version}
+		if *servers != "" {
+			opts.Servers = strings.Split(*servers, ",")
+		}
+		doc, err := catalog.OpenAPIJSON(opts)
+		if err != nil {
+			fail(err)
+		}
+		data = append(doc, '\n')
+	case "markdown":
+		doc, err := catalog.Markdown()
+		if err != nil {
+			fail(err)
+		}
+		data = []byte(doc)
+	default:
+		fail(fmt.Errorf("unknown format %q (want openapi or markdown)", *format))
+	}
+
+	if *out == "" {
+		os.Stdout.Write(data)
+		return
+	}
+	if err := os.WriteFile(*out, data, 0o644); err != nil {
+		fail(err)
+	}
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, "gen-catalog:", err)
+	os.Exit(1)
+}
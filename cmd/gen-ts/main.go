@@ -0,0 +1,49 @@
+// Command gen-ts writes TypeScript declaration files for the contract packages.
+//
+// Usage:
+//
+//	go run ./cmd/gen-ts -out ts
+//
+// Run it from the module root, or point -root at it.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/metaphi-labs/latent-contracts/tsgen"
+)
+
+func main() {
+	root := flag.String("root", ".", "module root containing go.mod")
+	out := flag.String("out", "ts", "output directory")
+	flag.Parse()
+
+	files, err := tsgen.Generate(*root)
+	if err != nil {
+		fail(err)
+	}
+	if err := os.MkdirAll(*out, 0o755); err != nil {
+		fail(err)
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := os.WriteFile(filepath.Join(*out, name), []byte(files[name]), 0o644); err != nil {
+			fail(err)
+		}
+	}
+	fmt.Printf("wrote %d declaration files to %s\n", len(names), *out)
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, "gen-ts:", err)
+	os.Exit(1)
+}
@@ -0,0 +1,139 @@
+package contractspb
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// timestamp converts a time; the zero time is left unset
+func timestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func timeOf(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
+func timestampPtr(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func timePtr(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+// value converts an untyped value through its JSON form; nil stays nil
+func value(v interface{}) (*structpb.Value, error) {
+	if v == nil {
+		return nil, nil
+	}
+	generic, err := jsonGeneric(v)
+	if err != nil {
+		return nil, err
+	}
+	return structpb.NewValue(generic)
+}
+
+func interfaceOf(v *structpb.Value) interface{} {
+	if v == nil {
+		return nil
+	}
+	return v.AsInterface()
+}
+
+// structOf converts a map through its JSON form; nil stays nil
+func structOf(m map[string]interface{}) (*structpb.Struct, error) {
+	if m == nil {
+		return nil, nil
+	}
+	generic, err := jsonGeneric(m)
+	if err != nil {
+		return nil, err
+	}
+	obj, ok := generic.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("map encodes as %T, not a JSON object", generic)
+	}
+	return structpb.NewStruct(obj)
+}
+
+func mapOf(s *structpb.Struct) map[string]interface{} {
+	if s == nil {
+		return nil
+	}
+	return s.AsMap()
+}
+
+// jsonGeneric re-decodes v as encoding/json would decode its JSON into an interface{}
+func jsonGeneric(v interface{}) (interface{}, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var generic interface{}
+	if err := json.Unmarshal(raw, &generic); err != nil {
+		return nil, err
+	}
+	return generic, nil
+}
+
+func int64Ptr(p *int) *int64 {
+	if p == nil {
+		return nil
+	}
+	n := int64(*p)
+	return &n
+}
+
+func intPtr(p *int64) *int {
+	if p == nil {
+		return nil
+	}
+	n := int(*p)
+	return &n
+}
+
+// convertAll converts a slice element by element, stopping at the first error
+func convertAll[From, To any](items []From, convert func(From) (To, error)) ([]To, error) {
+	if items == nil {
+		return nil, nil
+	}
+	out := make([]To, len(items))
+	for i, item := range items {
+		converted, err := convert(item)
+		if err != nil {
+			return nil, fmt.Errorf("[%d]: %w", i, err)
+		}
+		out[i] = converted
+	}
+	return out, nil
+}
+
+// mapAll converts a slice element by element
+func mapAll[From, To any](items []From, convert func(From) To) []To {
+	if len(items) == 0 {
+		return nil
+	}
+	out := make([]To, len(items))
+	for i, item := range items {
+		out[i] = convert(item)
+	}
+	return out
+}
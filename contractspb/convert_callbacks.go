@@ -0,0 +1,49 @@
+package contractspb
+
+import (
+	"fmt"
+
+	"github.com/metaphi-labs/latent-contracts/callbacks"
+)
+
+// CallbackRequestToProto converts a CallbackRequest; nil stays nil
+func CallbackRequestToProto(c *callbacks.CallbackRequest) (*CallbackRequest, error) {
+	if c == nil {
+		return nil, nil
+	}
+	result, err := ToolResultToProto(c.Result)
+	if err != nil {
+		return nil, fmt.Errorf("result: %w", err)
+	}
+	serviceError, err := ServiceErrorToProto(c.Error)
+	if err != nil {
+		return nil, fmt.Errorf("error: %w", err)
+	}
+	return &CallbackRequest{
+		JobId:          c.JobID,
+		UserId:         c.UserID,
+		ConversationId: c.ConversationID,
+		MessageId:      c.MessageID,
+		Tool:           c.Tool,
+		Status:         c.Status,
+		Result:         result,
+		Error:          serviceError,
+	}, nil
+}
+
+// CallbackRequestFromProto converts a CallbackRequest back; nil stays nil
+func CallbackRequestFromProto(p *CallbackRequest) *callbacks.CallbackRequest {
+	if p == nil {
+		return nil
+	}
+	return &callbacks.CallbackRequest{
+		JobID:          p.JobId,
+		UserID:         p.UserId,
+		ConversationID: p.ConversationId,
+		MessageID:      p.MessageId,
+		Tool:           p.Tool,
+		Status:         p.Status,
+		Result:         ToolResultFromProto(p.Result),
+		Error:          ServiceErrorFromProto(p.Error),
+	}
+}
@@ -0,0 +1,162 @@
+package contractspb
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/metaphi-labs/latent-contracts/errors"
+)
+
+// ServiceErrorToProto converts a ServiceError; nil stays nil.
+// Cause isn't serialized in JSON either; only CauseMessage is carried.
+func ServiceErrorToProto(e *errors.ServiceError) (*ServiceError, error) {
+	if e == nil {
+		return nil, nil
+	}
+	metadata, err := errorMetadataToProto(e.Metadata)
+	if err != nil {
+		return nil, fmt.Errorf("service error metadata: %w", err)
+	}
+	return &ServiceError{
+		Code:       string(e.Code),
+		Message:    e.Message,
+		Service:    e.Service,
+		HttpStatus: int64(e.HTTPStatus),
+		Category:   string(e.Category),
+		Severity:   string(e.Severity),
+		Retryable:  e.Retryable,
+		OccurredAt: timestamp(e.OccurredAt),
+		RequestId:  e.RequestID,
+		JobId:      e.JobID,
+		UserId:     e.UserID,
+		Cause:      e.CauseMessage,
+		Metadata:   metadata,
+	}, nil
+}
+
+// ServiceErrorFromProto converts a ServiceError back; nil stays nil
+func ServiceErrorFromProto(p *ServiceError) *errors.ServiceError {
+	if p == nil {
+		return nil
+	}
+	return &errors.ServiceError{
+		Code:         errors.ErrorCode(p.Code),
+		Message:      p.Message,
+		Service:      p.Service,
+		HTTPStatus:   int(p.HttpStatus),
+		Category:     errors.ErrorCategory(p.Category),
+		Severity:     errors.Severity(p.Severity),
+		Retryable:    p.Retryable,
+		OccurredAt:   timeOf(p.OccurredAt),
+		RequestID:    p.RequestId,
+		JobID:        p.JobId,
+		UserID:       p.UserId,
+		CauseMessage: p.Cause,
+		Metadata:     errorMetadataFromProto(p.Metadata),
+	}
+}
+
+func errorMetadataToProto(m *errors.ErrorMetadata) (*ErrorMetadata, error) {
+	if m == nil {
+		return nil, nil
+	}
+	validation, err := convertAll(m.ValidationDetails, validationDetailToProto)
+	if err != nil {
+		return nil, fmt.Errorf("validation_details%w", err)
+	}
+	providerData, err := structOf(m.ProviderData)
+	if err != nil {
+		return nil, fmt.Errorf("provider_data: %w", err)
+	}
+	details, err := structOf(m.Details)
+	if err != nil {
+		return nil, fmt.Errorf("details: %w", err)
+	}
+
+	p := &ErrorMetadata{
+		ValidationDetails: validation,
+		ViolationDetails:  mapAll(m.ViolationDetails, violationDetailToProto),
+		QuotaLimit:        int64(m.QuotaLimit),
+		QuotaUsed:         int64(m.QuotaUsed),
+		Provider:          m.Provider,
+		ProviderCode:      m.ProviderCode,
+		ProviderData:      providerData,
+		Details:           details,
+	}
+	if m.RetryAfter != nil {
+		p.RetryAfter = durationpb.New(*m.RetryAfter)
+	}
+	return p, nil
+}
+
+func errorMetadataFromProto(p *ErrorMetadata) *errors.ErrorMetadata {
+	if p == nil {
+		return nil
+	}
+	m := &errors.ErrorMetadata{
+		ValidationDetails: mapAll(p.ValidationDetails, validationDetailFromProto),
+		ViolationDetails:  mapAll(p.ViolationDetails, violationDetailFromProto),
+		QuotaLimit:        int(p.QuotaLimit),
+		QuotaUsed:         int(p.QuotaUsed),
+		Provider:          p.Provider,
+		ProviderCode:      p.ProviderCode,
+		ProviderData:      mapOf(p.ProviderData),
+		Details:           mapOf(p.Details),
+	}
+	if p.RetryAfter != nil {
+		retryAfter := p.RetryAfter.AsDuration()
+		m.RetryAfter = &retryAfter
+	}
+	return m
+}
+
+func validationDetailToProto(d errors.ValidationDetail) (*ValidationDetail, error) {
+	provided, err := value(d.Provided)
+	if err != nil {
+		return nil, fmt.Errorf("%s provided: %w", d.Field, err)
+	}
+	expected, err := value(d.Expected)
+	if err != nil {
+		return nil, fmt.Errorf("%s expected: %w", d.Field, err)
+	}
+	return &ValidationDetail{
+		Field:      d.Field,
+		Provided:   provided,
+		Expected:   expected,
+		Reason:     d.Reason,
+		Code:       string(d.Code),
+		Suggestion: d.Suggestion,
+	}, nil
+}
+
+func validationDetailFromProto(p *ValidationDetail) errors.ValidationDetail {
+	return errors.ValidationDetail{
+		Field:      p.Field,
+		Provided:   interfaceOf(p.Provided),
+		Expected:   interfaceOf(p.Expected),
+		Reason:     p.Reason,
+		Code:       errors.ErrorCode(p.Code),
+		Suggestion: p.Suggestion,
+	}
+}
+
+func violationDetailToProto(d errors.ViolationDetail) *ViolationDetail {
+	return &ViolationDetail{
+		Type:         d.Type,
+		Description:  d.Description,
+		Severity:     string(d.Severity),
+		Confidence:   d.Confidence,
+		ProviderCode: d.ProviderCode,
+	}
+}
+
+func violationDetailFromProto(p *ViolationDetail) errors.ViolationDetail {
+	return errors.ViolationDetail{
+		Type:         p.Type,
+		Description:  p.Description,
+		Severity:     errors.Severity(p.Severity),
+		Confidence:   p.Confidence,
+		ProviderCode: p.ProviderCode,
+	}
+}
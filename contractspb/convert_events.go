@@ -0,0 +1,94 @@
+package contractspb
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/metaphi-labs/latent-contracts/events"
+)
+
+// MediaEventToProto converts a MediaEvent; nil stays nil.
+// MediaEventData payloads are carried as typed messages, anything else as a Value.
+func MediaEventToProto(e *events.MediaEvent) (*MediaEvent, error) {
+	if e == nil {
+		return nil, nil
+	}
+	p := &MediaEvent{
+		Id:        e.ID,
+		EventType: string(e.Type),
+		UserId:    e.UserID,
+		Timestamp: e.Timestamp,
+	}
+
+	switch data := e.Data.(type) {
+	case events.MediaEventData:
+		p.Data = &MediaEvent_Media{Media: mediaEventDataToProto(data)}
+	case *events.MediaEventData:
+		if data != nil {
+			p.Data = &MediaEvent_Media{Media: mediaEventDataToProto(*data)}
+			break
+		}
+		p.Data = &MediaEvent_Other{Other: structpb.NewNullValue()}
+	default:
+		other, err := value(data)
+		if err != nil {
+			return nil, fmt.Errorf("data: %w", err)
+		}
+		if other == nil {
+			other = structpb.NewNullValue() // Data has no omitempty, so nil encodes as null
+		}
+		p.Data = &MediaEvent_Other{Other: other}
+	}
+	return p, nil
+}
+
+// MediaEventFromProto converts a MediaEvent back; nil stays nil.
+// Typed payloads come back as an events.MediaEventData value, as the event helpers build them.
+func MediaEventFromProto(p *MediaEvent) *events.MediaEvent {
+	if p == nil {
+		return nil
+	}
+	e := &events.MediaEvent{
+		ID:        p.Id,
+		Type:      events.EventType(p.EventType),
+		UserID:    p.UserId,
+		Timestamp: p.Timestamp,
+	}
+	switch data := p.Data.(type) {
+	case *MediaEvent_Media:
+		e.Data = mediaEventDataFromProto(data.Media)
+	case *MediaEvent_Other:
+		e.Data = interfaceOf(data.Other)
+	}
+	return e
+}
+
+func mediaEventDataToProto(d events.MediaEventData) *MediaEventData {
+	return &MediaEventData{
+		JobId:          d.JobID,
+		ConversationId: d.ConversationID,
+		ToolName:       d.ToolName,
+		Service:        d.Service,
+		Progress:       int64(d.Progress),
+		Message:        d.Message,
+		Reason:         d.Reason,
+		Details:        d.Details,
+	}
+}
+
+func mediaEventDataFromProto(p *MediaEventData) events.MediaEventData {
+	if p == nil {
+		return events.MediaEventData{}
+	}
+	return events.MediaEventData{
+		JobID:          p.JobId,
+		ConversationID: p.ConversationId,
+		ToolName:       p.ToolName,
+		Service:        p.Service,
+		Progress:       int(p.Progress),
+		Message:        p.Message,
+		Reason:         p.Reason,
+		Details:        p.Details,
+	}
+}
@@ -0,0 +1,117 @@
+package contractspb
+
+import (
+	"fmt"
+
+	"github.com/metaphi-labs/latent-contracts/progress"
+)
+
+// ProgressUpdateToProto converts a progress Update; nil stays nil
+func ProgressUpdateToProto(u *progress.Update) (*ProgressUpdate, error) {
+	if u == nil {
+		return nil, nil
+	}
+	partial, err := value(u.PartialResult)
+	if err != nil {
+		return nil, fmt.Errorf("partial_result: %w", err)
+	}
+	return &ProgressUpdate{
+		JobId:         u.JobID,
+		Tool:          u.Tool,
+		Status:        string(u.Status),
+		Progress:      int64(u.Progress),
+		Message:       u.Message,
+		CurrentStep:   u.CurrentStep,
+		TotalSteps:    int64(u.TotalSteps),
+		StepProgress:  int64(u.StepProgress),
+		UpdatedAt:     timestamp(u.UpdatedAt),
+		StartedAt:     timestampPtr(u.StartedAt),
+		EstimatedEnd:  timestampPtr(u.EstimatedEnd),
+		PartialResult: partial,
+	}, nil
+}
+
+// ProgressUpdateFromProto converts a progress Update back; nil stays nil
+func ProgressUpdateFromProto(p *ProgressUpdate) *progress.Update {
+	if p == nil {
+		return nil
+	}
+	return &progress.Update{
+		JobID:         p.JobId,
+		Tool:          p.Tool,
+		Status:        progress.Status(p.Status),
+		Progress:      int(p.Progress),
+		Message:       p.Message,
+		CurrentStep:   p.CurrentStep,
+		TotalSteps:    int(p.TotalSteps),
+		StepProgress:  int(p.StepProgress),
+		UpdatedAt:     timeOf(p.UpdatedAt),
+		StartedAt:     timePtr(p.StartedAt),
+		EstimatedEnd:  timePtr(p.EstimatedEnd),
+		PartialResult: interfaceOf(p.PartialResult),
+	}
+}
+
+// BatchUpdateToProto converts a BatchUpdate; nil stays nil
+func BatchUpdateToProto(u *progress.BatchUpdate) (*BatchUpdate, error) {
+	if u == nil {
+		return nil, nil
+	}
+	items, err := convertAll(u.ItemStatuses, itemStatusToProto)
+	if err != nil {
+		return nil, fmt.Errorf("item_statuses%w", err)
+	}
+	return &BatchUpdate{
+		JobId:          u.JobID,
+		Tool:           u.Tool,
+		TotalItems:     int64(u.TotalItems),
+		CompletedItems: int64(u.CompletedItems),
+		FailedItems:    int64(u.FailedItems),
+		CurrentItem:    u.CurrentItem,
+		Progress:       int64(u.Progress),
+		UpdatedAt:      timestamp(u.UpdatedAt),
+		ItemStatuses:   items,
+	}, nil
+}
+
+// BatchUpdateFromProto converts a BatchUpdate back; nil stays nil
+func BatchUpdateFromProto(p *BatchUpdate) *progress.BatchUpdate {
+	if p == nil {
+		return nil
+	}
+	return &progress.BatchUpdate{
+		JobID:          p.JobId,
+		Tool:           p.Tool,
+		TotalItems:     int(p.TotalItems),
+		CompletedItems: int(p.CompletedItems),
+		FailedItems:    int(p.FailedItems),
+		CurrentItem:    p.CurrentItem,
+		Progress:       int(p.Progress),
+		UpdatedAt:      timeOf(p.UpdatedAt),
+		ItemStatuses:   mapAll(p.ItemStatuses, itemStatusFromProto),
+	}
+}
+
+func itemStatusToProto(s progress.ItemStatus) (*ItemStatus, error) {
+	result, err := value(s.Result)
+	if err != nil {
+		return nil, fmt.Errorf("%s result: %w", s.ID, err)
+	}
+	return &ItemStatus{
+		Id:       s.ID,
+		Status:   string(s.Status),
+		Progress: int64(s.Progress),
+		Error:    s.Error,
+		Result:   result,
+	}, nil
+}
+
+func itemStatusFromProto(p *ItemStatus) progress.ItemStatus {
+	return progress.ItemStatus{
+		ID:       p.Id,
+		Status:   progress.Status(p.Status),
+		Progress: int(p.Progress),
+		Error:    p.Error,
+		Result:   interfaceOf(p.Result),
+	}
+}
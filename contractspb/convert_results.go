@@ -0,0 +1,289 @@
+package contractspb
+
+import (
+	"fmt"
+
+	"github.com/metaphi-labs/latent-contracts/results"
+	"github.com/metaphi-labs/latent-contracts/types"
+)
+
+// ToolResultToProto converts a ToolResult; nil stays nil
+func ToolResultToProto(r *results.ToolResult) (*ToolResult, error) {
+	if r == nil {
+		return nil, nil
+	}
+	media, err := mediaGenerationToProto(r.MediaGeneration)
+	if err != nil {
+		return nil, fmt.Errorf("media_generation: %w", err)
+	}
+	processing, err := videoProcessingToProto(r.VideoProcessing)
+	if err != nil {
+		return nil, fmt.Errorf("video_processing: %w", err)
+	}
+	serviceError, err := ServiceErrorToProto(r.Error)
+	if err != nil {
+		return nil, fmt.Errorf("error: %w", err)
+	}
+	return &ToolResult{
+		Success:         r.Success,
+		Tool:            r.Tool,
+		MediaGeneration: media,
+		VideoProcessing: processing,
+		Error:           serviceError,
+		Metadata:        executionMetadataToProto(r.Metadata),
+	}, nil
+}
+
+// ToolResultFromProto converts a ToolResult back; nil stays nil
+func ToolResultFromProto(p *ToolResult) *results.ToolResult {
+	if p == nil {
+		return nil
+	}
+	return &results.ToolResult{
+		Success:         p.Success,
+		Tool:            p.Tool,
+		MediaGeneration: mediaGenerationFromProto(p.MediaGeneration),
+		VideoProcessing: videoProcessingFromProto(p.VideoProcessing),
+		Error:           ServiceErrorFromProto(p.Error),
+		Metadata:        executionMetadataFromProto(p.Metadata),
+	}
+}
+
+func executionMetadataToProto(m results.ExecutionMetadata) *ExecutionMetadata {
+	return &ExecutionMetadata{
+		StartTime:     timestamp(m.StartTime),
+		EndTime:       timestamp(m.EndTime),
+		DurationMs:    m.DurationMs,
+		CreatedAt:     timestamp(m.CreatedAt),
+		CreditsUsed:   int64(m.CreditsUsed),
+		TokensUsed:    int64Ptr(m.TokensUsed),
+		Provider:      m.Provider,
+		Model:         m.Model,
+		ProviderJobId: m.ProviderJobID,
+		Region:        m.Region,
+		RequestId:     m.RequestID,
+	}
+}
+
+func executionMetadataFromProto(p *ExecutionMetadata) results.ExecutionMetadata {
+	if p == nil {
+		return results.ExecutionMetadata{}
+	}
+	return results.ExecutionMetadata{
+		StartTime:     timeOf(p.StartTime),
+		EndTime:       timeOf(p.EndTime),
+		DurationMs:    p.DurationMs,
+		CreatedAt:     timeOf(p.CreatedAt),
+		CreditsUsed:   int(p.CreditsUsed),
+		TokensUsed:    intPtr(p.TokensUsed),
+		Provider:      p.Provider,
+		Model:         p.Model,
+		ProviderJobID: p.ProviderJobId,
+		Region:        p.Region,
+		RequestID:     p.RequestId,
+	}
+}
+
+func mediaGenerationToProto(m *results.MediaGenerationResult) (*MediaGenerationResult, error) {
+	if m == nil {
+		return nil, nil
+	}
+	parameters, err := structOf(m.Parameters)
+	if err != nil {
+		return nil, fmt.Errorf("parameters: %w", err)
+	}
+	return &MediaGenerationResult{
+		Images:           mapAll(m.Images, outputImageToProto),
+		Videos:           mapAll(m.Videos, outputVideoToProto),
+		Audio:            mapAll(m.Audio, outputAudioToProto),
+		Prompt:           m.Prompt,
+		Model:            m.Model,
+		Seed:             int64Ptr(m.Seed),
+		AspectRatio:      m.AspectRatio,
+		NegativePrompt:   m.NegativePrompt,
+		SafetyLevel:      m.SafetyLevel,
+		PersonGeneration: m.PersonGeneration,
+		StartImageUrl:    m.StartImage,
+		EndImageUrl:      m.EndImage,
+		CameraMovement:   m.CameraMovement,
+		AudioGenerated:   m.AudioGenerated,
+		Genre:            m.Genre,
+		Instruments:      m.Instruments,
+		Mood:             m.Mood,
+		TotalRequested:   int64(m.TotalRequested),
+		TotalGenerated:   int64(m.TotalGenerated),
+		Parameters:       parameters,
+	}, nil
+}
+
+func mediaGenerationFromProto(p *MediaGenerationResult) *results.MediaGenerationResult {
+	if p == nil {
+		return nil
+	}
+	return &results.MediaGenerationResult{
+		Images:           mapAll(p.Images, outputImageFromProto),
+		Videos:           mapAll(p.Videos, outputVideoFromProto),
+		Audio:            mapAll(p.Audio, outputAudioFromProto),
+		Prompt:           p.Prompt,
+		Model:            p.Model,
+		Seed:             intPtr(p.Seed),
+		AspectRatio:      p.AspectRatio,
+		NegativePrompt:   p.NegativePrompt,
+		SafetyLevel:      p.SafetyLevel,
+		PersonGeneration: p.PersonGeneration,
+		StartImage:       p.StartImageUrl,
+		EndImage:         p.EndImageUrl,
+		CameraMovement:   p.CameraMovement,
+		AudioGenerated:   p.AudioGenerated,
+		Genre:            p.Genre,
+		Instruments:      p.Instruments,
+		Mood:             p.Mood,
+		TotalRequested:   int(p.TotalRequested),
+		TotalGenerated:   int(p.TotalGenerated),
+		Parameters:       mapOf(p.Parameters),
+	}
+}
+
+func videoProcessingToProto(v *results.VideoProcessingResult) (*VideoProcessingResult, error) {
+	if v == nil {
+		return nil, nil
+	}
+	operations, err := convertAll(v.Operations, processingOperationToProto)
+	if err != nil {
+		return nil, fmt.Errorf("operations%w", err)
+	}
+	return &VideoProcessingResult{
+		Images:                mapAll(v.Images, outputImageToProto),
+		Videos:                mapAll(v.Videos, outputVideoToProto),
+		Audio:                 mapAll(v.Audio, outputAudioToProto),
+		InputAssets:           mapAll(v.InputAssets, inputReferenceToProto),
+		Operations:            operations,
+		ProcessingTimeSeconds: v.ProcessingTime,
+		InputSizeBytes:        v.InputSize,
+		OutputSizeBytes:       v.OutputSize,
+	}, nil
+}
+
+func videoProcessingFromProto(p *VideoProcessingResult) *results.VideoProcessingResult {
+	if p == nil {
+		return nil
+	}
+	return &results.VideoProcessingResult{
+		Images:         mapAll(p.Images, outputImageFromProto),
+		Videos:         mapAll(p.Videos, outputVideoFromProto),
+		Audio:          mapAll(p.Audio, outputAudioFromProto),
+		InputAssets:    append([]results.InputReference{}, mapAll(p.InputAssets, inputReferenceFromProto)...),
+		Operations:     append([]results.ProcessingOperation{}, mapAll(p.Operations, processingOperationFromProto)...),
+		ProcessingTime: p.ProcessingTimeSeconds,
+		InputSize:      p.InputSizeBytes,
+		OutputSize:     p.OutputSizeBytes,
+	}
+}
+
+func inputReferenceToProto(r results.InputReference) *InputReference {
+	return &InputReference{Type: r.Type, SourceUrl: r.SourceURL, Duration: r.Duration, Resolution: r.Resolution}
+}
+
+func inputReferenceFromProto(p *InputReference) results.InputReference {
+	return results.InputReference{Type: p.Type, SourceURL: p.SourceUrl, Duration: p.Duration, Resolution: p.Resolution}
+}
+
+func processingOperationToProto(o results.ProcessingOperation) (*ProcessingOperation, error) {
+	parameters, err := structOf(o.Parameters)
+	if err != nil {
+		return nil, fmt.Errorf("%s parameters: %w", o.Type, err)
+	}
+	return &ProcessingOperation{Type: o.Type, Parameters: parameters}, nil
+}
+
+func processingOperationFromProto(p *ProcessingOperation) results.ProcessingOperation {
+	return results.ProcessingOperation{Type: p.Type, Parameters: mapOf(p.Parameters)}
+}
+
+func outputImageToProto(i types.OutputImage) *OutputImage {
+	return &OutputImage{
+		Id:           i.ID,
+		Index:        int64(i.Index),
+		StorageUrl:   i.StorageURL,
+		PublicUrl:    i.PublicURL,
+		MimeType:     i.MimeType,
+		Width:        int64Ptr(i.Width),
+		Height:       int64Ptr(i.Height),
+		SignedUrl:    i.SignedURL,
+		SignedExpiry: timestampPtr(i.SignedExpiry),
+	}
+}
+
+func outputImageFromProto(p *OutputImage) types.OutputImage {
+	return types.OutputImage{
+		ID:           p.Id,
+		Index:        int(p.Index),
+		StorageURL:   p.StorageUrl,
+		PublicURL:    p.PublicUrl,
+		MimeType:     p.MimeType,
+		Width:        intPtr(p.Width),
+		Height:       intPtr(p.Height),
+		SignedURL:    p.SignedUrl,
+		SignedExpiry: timePtr(p.SignedExpiry),
+	}
+}
+
+func outputVideoToProto(v types.OutputVideo) *OutputVideo {
+	return &OutputVideo{
+		Id:              v.ID,
+		Index:           int64(v.Index),
+		StorageUrl:      v.StorageURL,
+		PublicUrl:       v.PublicURL,
+		MimeType:        v.MimeType,
+		DurationSeconds: v.Duration,
+		Width:           int64Ptr(v.Width),
+		Height:          int64Ptr(v.Height),
+		SignedUrl:       v.SignedURL,
+		SignedExpiry:    timestampPtr(v.SignedExpiry),
+	}
+}
+
+func outputVideoFromProto(p *OutputVideo) types.OutputVideo {
+	return types.OutputVideo{
+		ID:           p.Id,
+		Index:        int(p.Index),
+		StorageURL:   p.StorageUrl,
+		PublicURL:    p.PublicUrl,
+		MimeType:     p.MimeType,
+		Duration:     p.DurationSeconds,
+		Width:        intPtr(p.Width),
+		Height:       intPtr(p.Height),
+		SignedURL:    p.SignedUrl,
+		SignedExpiry: timePtr(p.SignedExpiry),
+	}
+}
+
+func outputAudioToProto(a types.OutputAudio) *OutputAudio {
+	return &OutputAudio{
+		Id:              a.ID,
+		Index:           int64(a.Index),
+		StorageUrl:      a.StorageURL,
+		PublicUrl:       a.PublicURL,
+		MimeType:        a.MimeType,
+		DurationSeconds: a.Duration,
+		SampleRate:      int64Ptr(a.SampleRate),
+		Channels:        int64Ptr(a.Channels),
+		SignedUrl:       a.SignedURL,
+		SignedExpiry:    timestampPtr(a.SignedExpiry),
+	}
+}
+
+func outputAudioFromProto(p *OutputAudio) types.OutputAudio {
+	return types.OutputAudio{
+		ID:           p.Id,
+		Index:        int(p.Index),
+		StorageURL:   p.StorageUrl,
+		PublicURL:    p.PublicUrl,
+		MimeType:     p.MimeType,
+		Duration:     p.DurationSeconds,
+		SampleRate:   intPtr(p.SampleRate),
+		Channels:     intPtr(p.Channels),
+		SignedURL:    p.SignedUrl,
+		SignedExpiry: timePtr(p.SignedExpiry),
+	}
+}
@@ -0,0 +1,244 @@
+package contractspb
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/metaphi-labs/latent-contracts/callbacks"
+	"github.com/metaphi-labs/latent-contracts/errors"
+	"github.com/metaphi-labs/latent-contracts/events"
+	"github.com/metaphi-labs/latent-contracts/progress"
+	"github.com/metaphi-labs/latent-contracts/results"
+	"github.com/metaphi-labs/latent-contracts/types"
+)
+
+// parityCase is one contract value whose JSON encoding must survive a round trip
+type parityCase struct {
+	name  string
+	value interface{} // *events.MediaEvent, *progress.Update, *progress.BatchUpdate, *callbacks.CallbackRequest, *results.ToolResult or *errors.ServiceError
+}
+
+// TestRoundTripPreservesJSON converts each contract to protobuf, through the wire
+// format and back, and fails when its JSON encoding changed. The fixtures populate
+// every field of every converted type.
+func TestRoundTripPreservesJSON(t *testing.T) {
+	for _, c := range parityFixtures() {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			back, err := roundTrip(c.value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want, err := canonicalJSON(c.value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := canonicalJSON(back)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(want, got) {
+				t.Errorf("JSON changed in round trip:\n  before: %s\n  after:  %s", want, got)
+			}
+		})
+	}
+}
+
+// canonicalJSON encodes v with object keys sorted, so struct field order doesn't count as a change
+func canonicalJSON(v interface{}) ([]byte, error) {
+	generic, err := jsonGeneric(v)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(generic)
+}
+
+// roundTrip converts v to its message, marshals and unmarshals it, and converts it back
+func roundTrip(v interface{}) (interface{}, error) {
+	switch value := v.(type) {
+	case *events.MediaEvent:
+		p, err := MediaEventToProto(value)
+		if err != nil {
+			return nil, err
+		}
+		out := &MediaEvent{}
+		if err := wire(p, out); err != nil {
+			return nil, err
+		}
+		return MediaEventFromProto(out), nil
+	case *progress.Update:
+		p, err := ProgressUpdateToProto(value)
+		if err != nil {
+			return nil, err
+		}
+		out := &ProgressUpdate{}
+		if err := wire(p, out); err != nil {
+			return nil, err
+		}
+		return ProgressUpdateFromProto(out), nil
+	case *progress.BatchUpdate:
+		p, err := BatchUpdateToProto(value)
+		if err != nil {
+			return nil, err
+		}
+		out := &BatchUpdate{}
+		if err := wire(p, out); err != nil {
+			return nil, err
+		}
+		return BatchUpdateFromProto(out), nil
+	case *callbacks.CallbackRequest:
+		p, err := CallbackRequestToProto(value)
+		if err != nil {
+			return nil, err
+		}
+		out := &CallbackRequest{}
+		if err := wire(p, out); err != nil {
+			return nil, err
+		}
+		return CallbackRequestFromProto(out), nil
+	case *results.ToolResult:
+		p, err := ToolResultToProto(value)
+		if err != nil {
+			return nil, err
+		}
+		out := &ToolResult{}
+		if err := wire(p, out); err != nil {
+			return nil, err
+		}
+		return ToolResultFromProto(out), nil
+	case *errors.ServiceError:
+		p, err := ServiceErrorToProto(value)
+		if err != nil {
+			return nil, err
+		}
+		out := &ServiceError{}
+		if err := wire(p, out); err != nil {
+			return nil, err
+		}
+		return ServiceErrorFromProto(out), nil
+	}
+	return nil, fmt.Errorf("no protobuf mirror for %T", v)
+}
+
+// wire marshals in and unmarshals the bytes into out
+func wire(in, out proto.Message) error {
+	raw, err := proto.Marshal(in)
+	if err != nil {
+		return err
+	}
+	return proto.Unmarshal(raw, out)
+}
+
+// parityFixtures covers every field, including nested, optional and untyped ones
+func parityFixtures() []parityCase {
+	at := time.Date(2026, time.March, 14, 9, 26, 53, 589793000, time.UTC)
+	later := at.Add(90 * time.Second)
+	retryAfter := 45 * time.Second
+	confidence := 0.93
+	width, height, tokens, seed := 1920, 1080, 512, 42
+	duration, sampleRate, channels := 8.0, 48000, 2
+	signed, ratio, resolution := "https://signed.example/a.png?sig=1", "16:9", "1080p"
+	yes := true
+	itemError := "source unavailable"
+
+	serviceError := &errors.ServiceError{
+		Code:         errors.VAL_INVALID_ENUM,
+		Message:      "validation failed",
+		Service:      "tool-validation",
+		HTTPStatus:   400,
+		Category:     errors.CategoryValidation,
+		Severity:     errors.SeverityLow,
+		Retryable:    false,
+		OccurredAt:   at,
+		RequestID:    "req-1",
+		JobID:        "job-1",
+		UserID:       "user-1",
+		CauseMessage: "upstream said no",
+		Metadata: &errors.ErrorMetadata{
+			ValidationDetails: []errors.ValidationDetail{
+				{Field: "aspect_ratio", Provided: "16:8", Expected: []string{"16:9", "9:16"}, Reason: "must be one of: 16:9 9:16", Code: errors.VAL_INVALID_ENUM, Suggestion: "16:9"},
+				{Field: "duration", Provided: 12, Expected: map[string]interface{}{"minimum": 5, "maximum": 8}, Reason: "must be at most 8", Code: errors.VAL_OUT_OF_RANGE},
+			},
+			ViolationDetails: []errors.ViolationDetail{
+				{Type: "VIOLENCE", Description: "violent content", Severity: errors.SeverityHigh, Confidence: &confidence, ProviderCode: "17301594"},
+			},
+			RetryAfter:   &retryAfter,
+			QuotaLimit:   100,
+			QuotaUsed:    101,
+			Provider:     "vertex-ai",
+			ProviderCode: "429",
+			ProviderData: map[string]interface{}{"region": "us-central1", "attempts": 3, "nested": map[string]interface{}{"ok": false}},
+			Details:      map[string]interface{}{"hint": "retry later", "codes": []interface{}{1, "two", nil}},
+		},
+	}
+
+	image := types.OutputImage{ID: "img-1", Index: 0, StorageURL: "gs://b/a.png", PublicURL: "https://cdn/a.png", MimeType: "image/png",
+		Width: &width, Height: &height, SignedURL: &signed, SignedExpiry: &later}
+	video := types.OutputVideo{ID: "vid-1", Index: 1, StorageURL: "gs://b/a.mp4", PublicURL: "https://cdn/a.mp4", MimeType: "video/mp4",
+		Duration: &duration, Width: &width, Height: &height}
+	audio := types.OutputAudio{ID: "aud-1", StorageURL: "gs://b/a.wav", PublicURL: "https://cdn/a.wav", MimeType: "audio/wav",
+		Duration: &duration, SampleRate: &sampleRate, Channels: &channels}
+	metadata := results.ExecutionMetadata{StartTime: at, EndTime: later, DurationMs: 90000, CreatedAt: later, CreditsUsed: 300,
+		TokensUsed: &tokens, Provider: "vertex-ai", Model: "veo-3.0", ProviderJobID: "op-7", Region: "us-central1", RequestID: "req-1"}
+
+	generation := &results.ToolResult{
+		Success: true,
+		Tool:    "generate-video-veo3",
+		MediaGeneration: &results.MediaGenerationResult{
+			Images: []types.OutputImage{image}, Videos: []types.OutputVideo{video}, Audio: []types.OutputAudio{audio},
+			Prompt: "a lighthouse at dusk", Model: "veo-3.0", Seed: &seed, AspectRatio: &ratio, NegativePrompt: &signed,
+			SafetyLevel: &resolution, PersonGeneration: &yes, StartImage: &signed, EndImage: &signed, CameraMovement: &ratio,
+			AudioGenerated: &yes, Genre: &ratio, Instruments: &ratio, Mood: &ratio, TotalRequested: 2, TotalGenerated: 1,
+			Parameters: map[string]interface{}{"duration": 8, "fps": 24, "resolution": "1080p", "generate_audio": true},
+		},
+		Metadata: metadata,
+	}
+	processing := &results.ToolResult{
+		Success: true,
+		Tool:    "trim-video",
+		VideoProcessing: &results.VideoProcessingResult{
+			Videos:         []types.OutputVideo{video},
+			InputAssets:    []results.InputReference{{Type: "video", SourceURL: "gs://b/in.mp4", Duration: &duration, Resolution: &resolution}},
+			Operations:     []results.ProcessingOperation{{Type: "trim", Parameters: map[string]interface{}{"start": "00:01", "end": 5.5}}},
+			ProcessingTime: 2.25,
+			InputSize:      1 << 30,
+			OutputSize:     1 << 20,
+		},
+		Metadata: metadata,
+	}
+	minimal := errors.NewServiceError(errors.SYS_TIMEOUT, "timed out", "media-ai", true)
+	minimal.OccurredAt = at // NewServiceError uses local time, which comes back as UTC
+
+	failed := &results.ToolResult{Success: false, Tool: "generate-image-imagen", Error: serviceError, Metadata: metadata}
+
+	return []parityCase{
+		{"ServiceError", serviceError},
+		{"ServiceError/minimal", minimal},
+		{"ToolResult/media_generation", generation},
+		{"ToolResult/video_processing", processing},
+		{"ToolResult/failed", failed},
+		{"CallbackRequest/completed", &callbacks.CallbackRequest{JobID: "job-1", UserID: "user-1", ConversationID: "conv-1",
+			MessageID: "msg-1", Tool: "generate-video-veo3", Status: callbacks.StatusCompleted, Result: generation}},
+		{"CallbackRequest/failed", &callbacks.CallbackRequest{JobID: "job-2", UserID: "user-1", ConversationID: "conv-1",
+			Tool: "trim-video", Status: callbacks.StatusFailed, Error: serviceError}},
+		{"MediaEvent/progress", events.NewMediaProgress("job-1", "generate-video-veo3", "user-1", "conv-1", "media-ai", 40, "rendering")},
+		{"MediaEvent/rejected", events.NewMediaRejected("job-1", "generate-video-veo3", "user-1", "conv-1", "media-ai", "safety", "blocked")},
+		{"MediaEvent/untyped", &events.MediaEvent{ID: "evt-1", Type: events.EventToolCompleted, UserID: "user-1", Timestamp: at.UnixMilli(),
+			Data: map[string]interface{}{"jobId": "job-1", "assets": []interface{}{"a", "b"}}}},
+		{"MediaEvent/no_data", &events.MediaEvent{ID: "evt-2", Type: events.EventToolStarted, UserID: "user-1", Timestamp: at.UnixMilli()}},
+		{"progress.Update", &progress.Update{JobID: "job-1", Tool: "generate-video-veo3", Status: progress.StatusGenerating, Progress: 40,
+			Message: "rendering", CurrentStep: "render", TotalSteps: 3, StepProgress: 60, UpdatedAt: later, StartedAt: &at,
+			EstimatedEnd: &later, PartialResult: map[string]interface{}{"frames": 120}}},
+		{"progress.BatchUpdate", &progress.BatchUpdate{JobID: "job-1", Tool: "extract-frame", TotalItems: 3, CompletedItems: 1,
+			FailedItems: 1, CurrentItem: "frame-3", Progress: 66, UpdatedAt: later, ItemStatuses: []progress.ItemStatus{
+				{ID: "frame-1", Status: progress.StatusCompleted, Progress: 100, Result: image},
+				{ID: "frame-2", Status: progress.StatusFailed, Progress: 0, Error: &itemError},
+				{ID: "frame-3", Status: progress.StatusProcessing, Progress: 30},
+			}}},
+	}
+}
@@ -0,0 +1,15 @@
+// Package contractspb holds protobuf mirrors of the high-volume contracts (MediaEvent,
+// progress updates, CallbackRequest, ToolResult and ServiceError) and lossless
+// converters between them and the Go contract types.
+//
+// The *.pb.go files are generated from proto/latent/contracts/v1; the converters are
+// written by hand. Conversion preserves the JSON encoding of every value, with these
+// caveats:
+//   - times come back in UTC (the same instant)
+//   - untyped values (interface{} and map[string]interface{}) go through their JSON
+//     form, so numbers come back as float64 exactly as encoding/json would decode them
+//   - nil and empty slices are indistinguishable on the wire; fields without
+//     omitempty come back as empty slices
+package contractspb
+
+//go:generate protoc -I ../proto --go_out=.. --go_opt=module=github.com/metaphi-labs/latent-contracts latent/contracts/v1/errors.proto latent/contracts/v1/media.proto latent/contracts/v1/results.proto latent/contracts/v1/progress.proto latent/contracts/v1/events.proto latent/contracts/v1/callbacks.proto
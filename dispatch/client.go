@@ -0,0 +1,214 @@
+// Package dispatch sends tool calls to the async endpoints of the services that run
+// them. A Client maps each tools.ServiceType to a base URL, validates params with
+// tools.DecodeParams, POSTs them to ToolMeta.EndpointPath and returns the 202 job
+// acknowledgement, or the *errors.ServiceError the service responded with.
+package dispatch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/metaphi-labs/latent-contracts/errors"
+	"github.com/metaphi-labs/latent-contracts/messages"
+	"github.com/metaphi-labs/latent-contracts/tools"
+)
+
+// maxResponseBytes caps how much of a response body is read
+const maxResponseBytes = 1 << 20
+
+// Client dispatches tool calls over HTTP. It is safe for concurrent use.
+type Client struct {
+	baseURLs   map[tools.ServiceType]string
+	httpClient *http.Client
+	service    string
+	timeout    time.Duration
+	header     http.Header
+}
+
+// Option configures a Client
+type Option func(*Client)
+
+// WithBaseURL sets the base URL of a service, e.g. "http://media-ai:8080"
+func WithBaseURL(service tools.ServiceType, baseURL string) Option {
+	return func(c *Client) {
+		c.baseURLs[service] = strings.TrimSuffix(baseURL, "/")
+	}
+}
+
+// WithHTTPClient replaces http.DefaultClient
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithService sets the service named in errors the client builds itself
+func WithService(service string) Option {
+	return func(c *Client) {
+		c.service = service
+	}
+}
+
+// WithTimeout bounds each dispatch, from sending the request to reading the response
+func WithTimeout(d time.Duration) Option {
+	return func(c *Client) {
+		c.timeout = d
+	}
+}
+
+// WithHeader adds a header to every request, e.g. service-to-service auth
+func WithHeader(key, value string) Option {
+	return func(c *Client) {
+		c.header.Add(key, value)
+	}
+}
+
+// NewClient creates a client. Every service it dispatches to needs a WithBaseURL.
+func NewClient(opts ...Option) *Client {
+	c := &Client{
+		baseURLs:   make(map[tools.ServiceType]string),
+		httpClient: http.DefaultClient,
+		service:    "dispatch",
+		header:     make(http.Header),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Dispatch validates params for a tool and starts its job. Invalid params return the
+// VAL_* ServiceError from tools.DecodeParams without sending anything. Error responses
+// are decoded into a ServiceError; timeouts and network failures become retryable
+// SYS_TIMEOUT and SYS_NETWORK_ERROR ServiceErrors. Cancelling ctx returns ctx.Err().
+func (c *Client) Dispatch(ctx context.Context, toolName string, params map[string]interface{}, opts ...tools.DecodeOption) (*messages.JobAccepted, error) {
+	name, _, exists := tools.ResolveToolName(toolName)
+	if !exists {
+		return nil, errors.NewServiceError(errors.TOOL_NOT_FOUND, fmt.Sprintf("Tool '%s' not found", toolName), c.service, false)
+	}
+	meta, _ := tools.GetToolMetadata(name)
+	if meta.EndpointPath == "" {
+		return nil, fmt.Errorf("tool %s is not served over HTTP", name)
+	}
+	baseURL, exists := c.baseURLs[meta.ServiceType]
+	if !exists {
+		return nil, fmt.Errorf("no base URL configured for service %s", meta.ServiceType)
+	}
+
+	decoded, err := tools.DecodeParams(string(name), params, opts...)
+	if err != nil {
+		return nil, tools.ValidationErrorToServiceError(err, string(name), "")
+	}
+	body, err := json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("tool %s: encoding params: %w", name, err)
+	}
+
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, baseURL+meta.EndpointPath, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for key, values := range c.header {
+		req.Header[key] = values
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, c.transportError(ctx, name, err)
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+	if err != nil {
+		return nil, c.transportError(ctx, name, err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, responseError(meta.ServiceType, resp, respBody)
+	}
+	var accepted messages.JobAccepted
+	if err := json.Unmarshal(respBody, &accepted); err != nil {
+		return nil, fmt.Errorf("tool %s: decoding job acknowledgement: %w", name, err)
+	}
+	if accepted.JobID == "" {
+		return nil, fmt.Errorf("tool %s: job acknowledgement has no job_id", name)
+	}
+	return &accepted, nil
+}
+
+// transportError classifies a failure to get a response. The caller's cancellation
+// is returned as is; anything else is a retryable ServiceError.
+func (c *Client) transportError(ctx context.Context, name tools.ToolName, err error) error {
+	if ctxErr := ctx.Err(); ctxErr == context.Canceled {
+		return ctxErr
+	}
+	var netErr net.Error
+	if stderrors.Is(err, context.DeadlineExceeded) || (stderrors.As(err, &netErr) && netErr.Timeout()) {
+		return errors.TimeoutError(c.service, "dispatch "+string(name)).WithCause(err)
+	}
+	return errors.NewServiceError(errors.SYS_NETWORK_ERROR, fmt.Sprintf("Could not reach the service running '%s'", name), c.service, true).WithCause(err)
+}
+
+// responseError decodes a non-2xx response. Bodies that aren't a ServiceError, e.g. a
+// proxy's HTML error page, get a code derived from the HTTP status.
+func responseError(service tools.ServiceType, resp *http.Response, body []byte) *errors.ServiceError {
+	var serviceErr errors.ServiceError
+	if json.Unmarshal(body, &serviceErr) != nil || serviceErr.Code == "" {
+		code := statusCode(resp.StatusCode)
+		message := fmt.Sprintf("%s responded %s", service, resp.Status)
+		if text := strings.TrimSpace(string(body)); text != "" {
+			if len(text) > 200 {
+				text = text[:200] + "..."
+			}
+			message += ": " + text
+		}
+		serviceErr = *errors.NewServiceError(code, message, string(service), errors.IsRetryableCode(code))
+	}
+	serviceErr.HTTPStatus = resp.StatusCode
+
+	if serviceErr.Metadata == nil || serviceErr.Metadata.RetryAfter == nil {
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			serviceErr.WithRetryAfter(time.Duration(seconds) * time.Second)
+		}
+	}
+	return &serviceErr
+}
+
+// statusCode picks the error code for a status without a ServiceError body
+func statusCode(status int) errors.ErrorCode {
+	switch status {
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return errors.VAL_INVALID_REQUEST
+	case http.StatusUnauthorized:
+		return errors.AUTH_UNAUTHORIZED
+	case http.StatusForbidden:
+		return errors.AUTH_FORBIDDEN
+	case http.StatusNotFound:
+		return errors.TOOL_NOT_FOUND
+	case http.StatusRequestEntityTooLarge:
+		return errors.MEDIA_SIZE_TOO_LARGE
+	case http.StatusTooManyRequests:
+		return errors.RATE_LIMIT_EXCEEDED
+	case http.StatusBadGateway, http.StatusServiceUnavailable:
+		return errors.SYS_SERVICE_UNAVAILABLE
+	case http.StatusGatewayTimeout:
+		return errors.SYS_TIMEOUT
+	default:
+		return errors.SYS_INTERNAL_ERROR
+	}
+}
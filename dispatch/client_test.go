@@ -0,0 +1,192 @@
+package dispatch
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/metaphi-labs/latent-contracts/errors"
+	"github.com/metaphi-labs/latent-contracts/messages"
+	"github.com/metaphi-labs/latent-contracts/progress"
+	"github.com/metaphi-labs/latent-contracts/tools"
+)
+
+// testTool is dispatched by every test; its params are validParams
+const testTool = string(tools.GenerateImageImagen)
+
+var validParams = map[string]interface{}{"prompt": "a lighthouse at dusk"}
+
+func TestDispatchAccepted(t *testing.T) {
+	var received map[string]interface{}
+	var path, contentType, auth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, contentType, auth = r.URL.Path, r.Header.Get("Content-Type"), r.Header.Get("Authorization")
+		json.NewDecoder(r.Body).Decode(&received)
+		w.WriteHeader(http.StatusAccepted)
+		json.NewEncoder(w).Encode(messages.JobAccepted{JobID: "job-1", Tool: testTool, Status: progress.StatusQueued})
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(tools.ServiceTypeMediaAI, server.URL+"/"), WithHeader("Authorization", "Bearer token"))
+	accepted, err := client.Dispatch(context.Background(), testTool, validParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	meta, _ := tools.GetToolMetadata(tools.GenerateImageImagen)
+	if accepted.JobID != "job-1" || accepted.Status != progress.StatusQueued {
+		t.Errorf("acknowledgement decoded as %+v", accepted)
+	}
+	if path != meta.EndpointPath {
+		t.Errorf("posted to %s, want %s", path, meta.EndpointPath)
+	}
+	if contentType != "application/json" || auth != "Bearer token" {
+		t.Errorf("headers Content-Type=%q Authorization=%q", contentType, auth)
+	}
+	if received["prompt"] != validParams["prompt"] {
+		t.Errorf("service received params %v", received)
+	}
+}
+
+func TestDispatchMissingJobID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte(`{"status":"queued"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(tools.ServiceTypeMediaAI, server.URL))
+	if _, err := client.Dispatch(context.Background(), testTool, validParams); err == nil {
+		t.Fatal("accepted an acknowledgement without a job_id")
+	}
+}
+
+func TestDispatchInvalidParams(t *testing.T) {
+	var hits int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(tools.ServiceTypeMediaAI, server.URL))
+	_, err := client.Dispatch(context.Background(), testTool, map[string]interface{}{"number_of_images": 2})
+	expectCode(t, err, errors.VAL_MISSING_PARAMETER)
+	if atomic.LoadInt32(&hits) != 0 {
+		t.Error("invalid params were sent to the service")
+	}
+}
+
+func TestDispatchServiceError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := errors.NewServiceError(errors.AI_MODEL_OVERLOADED, "Imagen is overloaded", "media-ai", true).ToJSON()
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write(body)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(tools.ServiceTypeMediaAI, server.URL))
+	_, err := client.Dispatch(context.Background(), testTool, validParams)
+	serviceErr := expectCode(t, err, errors.AI_MODEL_OVERLOADED)
+	if serviceErr.Service != "media-ai" || serviceErr.Message != "Imagen is overloaded" || !serviceErr.Retryable {
+		t.Errorf("decoded as %+v", serviceErr)
+	}
+	if serviceErr.HTTPStatus != http.StatusServiceUnavailable {
+		t.Errorf("HTTP status %d, want %d", serviceErr.HTTPStatus, http.StatusServiceUnavailable)
+	}
+	if serviceErr.Metadata == nil || serviceErr.Metadata.RetryAfter == nil || *serviceErr.Metadata.RetryAfter != 30*time.Second {
+		t.Error("Retry-After header not carried over")
+	}
+}
+
+func TestDispatchPlainError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "<html>bad gateway</html>", http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(tools.ServiceTypeMediaAI, server.URL))
+	_, err := client.Dispatch(context.Background(), testTool, validParams)
+	serviceErr := expectCode(t, err, errors.SYS_SERVICE_UNAVAILABLE)
+	if !serviceErr.Retryable || serviceErr.HTTPStatus != http.StatusBadGateway {
+		t.Errorf("decoded as %+v", serviceErr)
+	}
+}
+
+func TestDispatchTimeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(io.Discard, r.Body) // the server notices the client leaving only once the body is read
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := NewClient(WithBaseURL(tools.ServiceTypeMediaAI, server.URL), WithTimeout(50*time.Millisecond))
+	_, err := client.Dispatch(context.Background(), testTool, validParams)
+	if serviceErr := expectCode(t, err, errors.SYS_TIMEOUT); !serviceErr.Retryable {
+		t.Error("timeout is not retryable")
+	}
+}
+
+func TestDispatchCancellation(t *testing.T) {
+	started := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(io.Discard, r.Body)
+		close(started)
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-started
+		cancel()
+	}()
+	client := NewClient(WithBaseURL(tools.ServiceTypeMediaAI, server.URL))
+	_, err := client.Dispatch(ctx, testTool, validParams)
+	if !stderrors.Is(err, context.Canceled) {
+		t.Fatalf("got %v, want context.Canceled", err)
+	}
+}
+
+func TestDispatchUnreachable(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	url := server.URL
+	server.Close()
+
+	client := NewClient(WithBaseURL(tools.ServiceTypeMediaAI, url))
+	_, err := client.Dispatch(context.Background(), testTool, validParams)
+	expectCode(t, err, errors.SYS_NETWORK_ERROR)
+}
+
+func TestDispatchConfiguration(t *testing.T) {
+	client := NewClient()
+	_, err := client.Dispatch(context.Background(), "no-such-tool", validParams)
+	expectCode(t, err, errors.TOOL_NOT_FOUND)
+
+	if _, err := client.Dispatch(context.Background(), testTool, validParams); err == nil {
+		t.Errorf("dispatched without a base URL for %s", tools.ServiceTypeMediaAI)
+	}
+}
+
+// expectCode fails the test unless err is a ServiceError with code
+func expectCode(t *testing.T, err error, code errors.ErrorCode) *errors.ServiceError {
+	t.Helper()
+	var serviceErr *errors.ServiceError
+	if !stderrors.As(err, &serviceErr) {
+		t.Fatalf("got %v, want a %s ServiceError", err, code)
+	}
+	if serviceErr.Code != code {
+		t.Fatalf("got %s (%s), want %s", serviceErr.Code, serviceErr.Message, code)
+	}
+	return serviceErr
+}
@@ -89,6 +89,12 @@ type ValidationDetail struct {
 	
 	// Human-readable reason for failure
 	Reason string `json:"reason"`
+	
+	// Code is the specific error code for this field (e.g. VAL_OUT_OF_RANGE)
+	Code ErrorCode `json:"code,omitempty"`
+	
+	// Suggestion is the nearest allowed value when Provided looks like a typo
+	Suggestion string `json:"suggestion,omitempty"`
 }
 
 // ViolationDetail describes a content policy violation
@@ -5,6 +5,8 @@ go 1.21
 require (
 	github.com/go-playground/validator/v10 v10.15.5
 	github.com/invopop/jsonschema v0.12.0
+	github.com/santhosh-tekuri/jsonschema/v5 v5.3.1
+	google.golang.org/protobuf v1.34.2
 )
 
 require (
@@ -0,0 +1,155 @@
+package messages
+
+import (
+	"fmt"
+
+	"github.com/metaphi-labs/latent-contracts/errors"
+	"github.com/metaphi-labs/latent-contracts/results"
+)
+
+// MaxBatchItems caps the items of one BatchToolCall
+const MaxBatchItems = 100
+
+// BatchToolCall represents a request to run one tool over many inputs as a single job
+// Purpose: Replace N independent ToolCalls (50 prompts, 20 videos) with one request whose
+// progress is reported as progress.BatchUpdate
+type BatchToolCall struct {
+	// Same meaning as ToolCall.Ref; item calls use "<ref>/<item id>"
+	Ref string `json:"ref"`
+
+	// Tool identification
+	Name string `json:"name"`
+
+	// Parameters shared by every item
+	Parameters map[string]interface{} `json:"parameters,omitempty"`
+
+	// One entry per tool run
+	Items []BatchItem `json:"items"`
+
+	// Items to run at once; a hint, 0 lets the service decide
+	Concurrency int `json:"concurrency,omitempty"`
+
+	// Conversation context
+	ConversationID string `json:"conversationId"`
+	UserID         string `json:"userId"`
+	MessageID      string `json:"messageId,omitempty"`
+}
+
+// BatchItem is one run within a batch
+type BatchItem struct {
+	ID         string                 `json:"id"`                   // Unique within the batch; reported as progress.ItemStatus.ID
+	Parameters map[string]interface{} `json:"parameters,omitempty"` // Replace shared parameters key by key
+}
+
+// Validate ensures the batch call is well-formed
+func (bc *BatchToolCall) Validate() error {
+	if bc.Ref == "" {
+		return fmt.Errorf("batch tool call ref is required")
+	}
+	if bc.Name == "" {
+		return fmt.Errorf("tool name is required")
+	}
+	if bc.ConversationID == "" {
+		return fmt.Errorf("conversationId is required")
+	}
+	if bc.UserID == "" {
+		return fmt.Errorf("userId is required")
+	}
+	if len(bc.Items) == 0 {
+		return fmt.Errorf("at least one item is required")
+	}
+	if len(bc.Items) > MaxBatchItems {
+		return fmt.Errorf("at most %d items are allowed, got %d", MaxBatchItems, len(bc.Items))
+	}
+	if bc.Concurrency < 0 {
+		return fmt.Errorf("concurrency must be non-negative")
+	}
+
+	seen := make(map[string]bool, len(bc.Items))
+	for i, item := range bc.Items {
+		if item.ID == "" {
+			return fmt.Errorf("items[%d].id is required", i)
+		}
+		if seen[item.ID] {
+			return fmt.Errorf("items[%d].id %q is duplicated", i, item.ID)
+		}
+		seen[item.ID] = true
+	}
+	return nil
+}
+
+// ItemCall returns the ToolCall for one item: shared parameters with the item's
+// overrides applied on top, key by key
+func (bc *BatchToolCall) ItemCall(i int) ToolCall {
+	item := bc.Items[i]
+	params := make(map[string]interface{}, len(bc.Parameters)+len(item.Parameters))
+	for key, value := range bc.Parameters {
+		params[key] = value
+	}
+	for key, value := range item.Parameters {
+		params[key] = value
+	}
+	return ToolCall{
+		Ref:            bc.Ref + "/" + item.ID,
+		Name:           bc.Name,
+		Parameters:     params,
+		ConversationID: bc.ConversationID,
+		UserID:         bc.UserID,
+		MessageID:      bc.MessageID,
+	}
+}
+
+// BatchResult is the outcome of a BatchToolCall, items in request order
+type BatchResult struct {
+	Ref   string            `json:"ref"`
+	Tool  string            `json:"tool"`
+	JobID string            `json:"jobId,omitempty"`
+	Items []BatchItemResult `json:"items"`
+}
+
+// BatchItemResult is the outcome of one item: a result, an error, or both when the
+// result failed. Items that failed before producing a result (invalid parameters,
+// cancelled batch) carry only the error.
+type BatchItemResult struct {
+	ID     string               `json:"id"`
+	Result *results.ToolResult  `json:"result,omitempty"`
+	Error  *errors.ServiceError `json:"error,omitempty"`
+}
+
+// Succeeded reports whether the item produced a successful result
+func (r *BatchItemResult) Succeeded() bool {
+	return r.Result != nil && r.Result.Success
+}
+
+// Failure returns the item's error, from the item or from its failed result.
+// A failed result without an error still fails the item, as TOOL_EXECUTION_FAILED.
+func (r *BatchItemResult) Failure() *errors.ServiceError {
+	if r.Error != nil {
+		return r.Error
+	}
+	if r.Result != nil && !r.Result.Success {
+		if r.Result.Error == nil {
+			return UnreportedFailure(r.Result.Tool)
+		}
+		return r.Result.Error
+	}
+	return nil
+}
+
+// UnreportedFailure is the error for a tool result with Success false and no Error
+func UnreportedFailure(tool string) *errors.ServiceError {
+	return errors.NewServiceError(errors.TOOL_EXECUTION_FAILED, fmt.Sprintf("%s failed without reporting an error", tool), tool, false)
+}
+
+// Counts returns how many items succeeded and failed; the rest haven't finished
+func (br *BatchResult) Counts() (succeeded, failed int) {
+	for i := range br.Items {
+		switch {
+		case br.Items[i].Succeeded():
+			succeeded++
+		case br.Items[i].Failure() != nil:
+			failed++
+		}
+	}
+	return succeeded, failed
+}
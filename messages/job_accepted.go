@@ -0,0 +1,11 @@
+package messages
+
+import "github.com/metaphi-labs/latent-contracts/progress"
+
+// JobAccepted is the 202 response body of every async tool endpoint.
+// The outcome is delivered later as a callbacks.CallbackRequest for the same job.
+type JobAccepted struct {
+	JobID  string          `json:"job_id"`
+	Tool   string          `json:"tool"`
+	Status progress.Status `json:"status" jsonschema:"enum=queued,enum=processing"`
+}
@@ -39,6 +39,7 @@ type PlanContext struct {
 	StepIndex  int    `json:"stepIndex"`  // Current step (0-based)
 	TotalSteps int    `json:"totalSteps"` // Total number of steps
 	IsLastStep bool   `json:"isLastStep"` // Convenience flag for final step
+	StepRef    string `json:"stepRef,omitempty"` // Step ref when the plan is a workflow.Workflow
 }
 
 // Validate ensures the message is well-formed
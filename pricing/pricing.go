@@ -0,0 +1,234 @@
+// Package pricing computes credit costs from a tool's decoded params.
+// The flat tools.ToolMeta.Credits value is the base price of one default output;
+// pricing scales it by what the call actually asks for.
+package pricing
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/metaphi-labs/latent-contracts/tools"
+)
+
+// ItemKind identifies how a line item contributes to an estimate
+type ItemKind string
+
+const (
+	ItemBase       ItemKind = "base"       // Fixed amount
+	ItemPerUnit    ItemKind = "per_unit"   // Quantity × UnitCredits
+	ItemMultiplier ItemKind = "multiplier" // Scales the sum of base and per-unit items
+)
+
+// Pricing inputs that aren't captured by tool metadata
+const (
+	veoClipSeconds           = 8             // ToolMeta.Credits for Veo covers one clip of this length
+	veo1080pMultiplier       = 1.25          // Surcharge for 1080p output over the 720p default
+	veoNoAudioMultiplier     = 200.0 / 300.0 // Veo3 without generate_audio, as priced by generate-video-veo3-no-audio
+	veoFastNoAudioMultiplier = 100.0 / 160.0 // Veo3 Fast without generate_audio, as priced by generate-video-veo3-fast-no-audio
+	combineIncludedInputs    = 2             // Inputs covered by combine-videos' base price
+	combinePerExtraInput     = 5             // Credits for each combine-videos input beyond the included ones
+	slideshowBlockSeconds    = 30.0          // images-to-video's base price covers this much output
+	defaultSecondsPerImage   = 3.0           // images-to-video's per-image duration if its params declare no default
+)
+
+// LineItem is one component of an estimate
+type LineItem struct {
+	Kind        ItemKind `json:"kind"`
+	Description string   `json:"description"`
+	Quantity    float64  `json:"quantity,omitempty"`     // Per-unit items only
+	UnitCredits float64  `json:"unit_credits,omitempty"` // Per-unit items only
+	Multiplier  float64  `json:"multiplier,omitempty"`   // Multiplier items only
+	Credits     float64  `json:"credits"`                // Amount added by this item
+}
+
+// Estimate is an itemized credit estimate for one tool call
+type Estimate struct {
+	Tool        tools.ToolName `json:"tool"`
+	BaseCredits int            `json:"base_credits"` // ToolMeta.Credits
+	Items       []LineItem     `json:"items"`
+	Units       int            `json:"units"`   // Outputs requested; used to prorate partial results
+	Credits     int            `json:"credits"` // Total, rounded up to whole credits
+}
+
+// EstimateParams decodes raw params for a tool and estimates the call.
+// Params must be valid; validation errors are returned unchanged.
+func EstimateParams(toolName string, params map[string]interface{}) (*Estimate, error) {
+	p, err := tools.DecodeParams(toolName, params)
+	if err != nil {
+		return nil, err
+	}
+	name, _, _ := tools.ResolveToolName(toolName)
+	return EstimateCall(name, p)
+}
+
+// EstimateCall estimates a call from decoded params (a params struct or a pointer to one).
+// Tools without parameter-aware pricing are charged their flat metadata credits.
+func EstimateCall(toolName tools.ToolName, params interface{}) (*Estimate, error) {
+	meta, exists := tools.GetToolMetadata(toolName)
+	if !exists {
+		return nil, fmt.Errorf("unknown tool: %s", toolName)
+	}
+	def, _ := tools.LookupTool(toolName)
+	params, err := paramsPointer(def.ParamsType, params)
+	if err != nil {
+		return nil, fmt.Errorf("tool %s: %w", toolName, err)
+	}
+
+	e := &estimator{base: float64(meta.Credits), units: 1}
+	switch p := params.(type) {
+	case *tools.GenerateImageImagenParams:
+		e.perUnit("image", countOrOne(p.NumberOfImages))
+	case *tools.GenerateImageImagenFastParams:
+		e.perUnit("image", countOrOne(p.NumberOfImages))
+	case *tools.GenerateImageImagenUltraParams:
+		e.perUnit("image", countOrOne(p.NumberOfImages))
+	case *tools.NanoBananaParams:
+		e.perUnit("image", countOrOne(p.NumberOfImages))
+	case *tools.GenerateVideoVeo3Params:
+		e.veo(p.SampleCount, p.Duration, p.Resolution)
+		if !p.GenerateAudio {
+			e.withoutAudio(veoNoAudioMultiplier)
+		}
+	case *tools.GenerateVideoVeo3NoAudioParams:
+		e.veo(p.SampleCount, p.Duration, p.Resolution)
+	case *tools.GenerateVideoVeo3FastParams:
+		e.veo(p.SampleCount, p.Duration, "")
+		if !p.GenerateAudio {
+			e.withoutAudio(veoFastNoAudioMultiplier)
+		}
+	case *tools.GenerateVideoVeo3FastNoAudioParams:
+		e.veo(p.SampleCount, p.Duration, "")
+	case *tools.GenerateMusicLyriaParams:
+		e.perUnit("track", countOrOne(p.SampleCount))
+	case *tools.CombineVideosParams:
+		e.flat()
+		if extra := len(p.Videos) - combineIncludedInputs; extra > 0 {
+			e.add(LineItem{
+				Kind:        ItemPerUnit,
+				Description: "additional input video",
+				Quantity:    float64(extra),
+				UnitCredits: combinePerExtraInput,
+			})
+		}
+	case *tools.ImagesToVideoParams:
+		perImage := p.Duration
+		if perImage <= 0 {
+			perImage = defaultSecondsPerImage
+		}
+		blocks := math.Max(1, math.Ceil(perImage*float64(len(p.Images))/slideshowBlockSeconds))
+		e.add(LineItem{
+			Kind:        ItemPerUnit,
+			Description: fmt.Sprintf("%.0fs of output", slideshowBlockSeconds),
+			Quantity:    blocks,
+			UnitCredits: e.base,
+		})
+	default:
+		e.flat()
+	}
+
+	return &Estimate{
+		Tool:        toolName,
+		BaseCredits: meta.Credits,
+		Items:       e.items,
+		Units:       e.units,
+		Credits:     e.total(),
+	}, nil
+}
+
+// estimator accumulates line items for one call
+type estimator struct {
+	base  float64
+	units int
+	items []LineItem
+}
+
+func (e *estimator) add(item LineItem) {
+	if item.Kind == ItemPerUnit {
+		item.Credits = item.Quantity * item.UnitCredits
+	}
+	e.items = append(e.items, item)
+}
+
+func (e *estimator) flat() {
+	e.add(LineItem{Kind: ItemBase, Description: "base price", Credits: e.base})
+}
+
+// perUnit charges the base price once per requested output
+func (e *estimator) perUnit(unit string, count int) {
+	e.units = count
+	e.add(LineItem{Kind: ItemPerUnit, Description: unit, Quantity: float64(count), UnitCredits: e.base})
+}
+
+// veo charges per clip, scaled by clip length and resolution
+func (e *estimator) veo(sampleCount, duration int, resolution string) {
+	e.perUnit("video", countOrOne(sampleCount))
+	if duration > 0 && duration != veoClipSeconds {
+		e.add(LineItem{
+			Kind:        ItemMultiplier,
+			Description: fmt.Sprintf("%ds clip", duration),
+			Multiplier:  float64(duration) / veoClipSeconds,
+		})
+	}
+	if resolution == "1080p" {
+		e.add(LineItem{Kind: ItemMultiplier, Description: "1080p resolution", Multiplier: veo1080pMultiplier})
+	}
+}
+
+// withoutAudio discounts a Veo call that doesn't generate audio; the tool's base price includes it
+func (e *estimator) withoutAudio(multiplier float64) {
+	e.add(LineItem{Kind: ItemMultiplier, Description: "without audio", Multiplier: multiplier})
+}
+
+// total sums base and per-unit items, applies multipliers and rounds up.
+// Multiplier items get their resulting contribution filled in.
+func (e *estimator) total() int {
+	subtotal := 0.0
+	for _, item := range e.items {
+		if item.Kind != ItemMultiplier {
+			subtotal += item.Credits
+		}
+	}
+	for i := range e.items {
+		if e.items[i].Kind == ItemMultiplier {
+			scaled := subtotal * e.items[i].Multiplier
+			e.items[i].Credits = scaled - subtotal
+			subtotal = scaled
+		}
+	}
+	// Round away float noise before rounding up, so 300 × 0.75 × 1.25 isn't 282
+	return int(math.Ceil(math.Round(subtotal*1e6) / 1e6))
+}
+
+// countOrOne treats an omitted count as a single output
+func countOrOne(n int) int {
+	if n <= 0 {
+		return 1
+	}
+	return n
+}
+
+// paramsPointer accepts a params struct or a pointer to one and returns a pointer
+// to a copy with the tool's defaults applied
+func paramsPointer(want reflect.Type, params interface{}) (interface{}, error) {
+	v := reflect.ValueOf(params)
+	switch {
+	case !v.IsValid():
+		return nil, fmt.Errorf("params are required")
+	case v.Type() == reflect.PtrTo(want):
+		if v.IsNil() {
+			return nil, fmt.Errorf("params are required")
+		}
+		v = v.Elem()
+	case v.Type() != want:
+		return nil, fmt.Errorf("expected %s params, got %T", want.Name(), params)
+	}
+
+	// Copy so defaults can be applied without touching the caller's params
+	ptr := reflect.New(want)
+	ptr.Elem().Set(v)
+	if err := tools.ApplyDefaults(ptr.Interface()); err != nil {
+		return nil, err
+	}
+	return ptr.Interface(), nil
+}
@@ -0,0 +1,70 @@
+package pricing
+
+import (
+	"testing"
+
+	"github.com/metaphi-labs/latent-contracts/tools"
+)
+
+func estimate(t *testing.T, tool tools.ToolName, params map[string]interface{}) int {
+	t.Helper()
+	e, err := EstimateParams(string(tool), params)
+	if err != nil {
+		t.Fatalf("%s: %v", tool, err)
+	}
+	return e.Credits
+}
+
+// A Veo call without audio costs what its no-audio variant charges for the same params
+func TestVeoWithoutAudioMatchesNoAudioVariant(t *testing.T) {
+	prompt := "a lighthouse at dusk, waves crashing"
+	for _, params := range []map[string]interface{}{
+		{"prompt": prompt},
+		{"prompt": prompt, "resolution": "1080p"},
+		{"prompt": prompt, "duration": 5, "sample_count": 3},
+	} {
+		if got, want := estimate(t, tools.GenerateVideoVeo3, params), estimate(t, tools.GenerateVideoVeo3NoAudio, params); got != want {
+			t.Errorf("veo3 %v: %d credits without audio, no-audio variant charges %d", params, got, want)
+		}
+	}
+	for _, params := range []map[string]interface{}{
+		{"prompt": prompt},
+		{"prompt": prompt, "duration": 6, "sample_count": 2},
+	} {
+		if got, want := estimate(t, tools.GenerateVideoVeo3Fast, params), estimate(t, tools.GenerateVideoVeo3FastNoAudio, params); got != want {
+			t.Errorf("veo3-fast %v: %d credits without audio, no-audio variant charges %d", params, got, want)
+		}
+	}
+}
+
+func TestVeoWithAudioChargesBasePrice(t *testing.T) {
+	params := map[string]interface{}{"prompt": "a lighthouse at dusk, waves crashing", "generate_audio": true}
+	for _, tool := range []tools.ToolName{tools.GenerateVideoVeo3, tools.GenerateVideoVeo3Fast} {
+		meta, _ := tools.GetToolMetadata(tool)
+		if got := estimate(t, tool, params); got != meta.Credits {
+			t.Errorf("%s with audio: %d credits, want %d", tool, got, meta.Credits)
+		}
+	}
+}
+
+func TestImageProcessingToolsAreNotFree(t *testing.T) {
+	images := func(n int) []interface{} {
+		list := make([]interface{}, n)
+		for i := range list {
+			list[i] = map[string]interface{}{"storage_url": "gs://bucket/image.png", "mime_type": "image/png"}
+		}
+		return list
+	}
+
+	if got := estimate(t, tools.MergeImages, map[string]interface{}{"images": images(3)}); got <= 0 {
+		t.Errorf("merge-images: %d credits", got)
+	}
+	short := estimate(t, tools.ImagesToVideo, map[string]interface{}{"images": images(3)})
+	if short <= 0 {
+		t.Errorf("images-to-video: %d credits", short)
+	}
+	long := estimate(t, tools.ImagesToVideo, map[string]interface{}{"images": images(12)})
+	if long <= short {
+		t.Errorf("images-to-video: 36s of output costs %d, 9s costs %d", long, short)
+	}
+}
@@ -0,0 +1,76 @@
+package pricing
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/metaphi-labs/latent-contracts/results"
+	"github.com/metaphi-labs/latent-contracts/tools"
+)
+
+// Charge is the reconciled charge for a finished tool call
+type Charge struct {
+	Estimated int     `json:"estimated"` // Credits from the estimate
+	Charged   int     `json:"charged"`   // Credits to bill
+	Refunded  int     `json:"refunded"`  // Estimated minus charged
+	Requested int     `json:"requested"` // Outputs requested
+	Delivered int     `json:"delivered"` // Outputs actually produced
+	Reason    string  `json:"reason,omitempty"`
+	Fraction  float64 `json:"fraction"` // Share of the estimate that was billed
+}
+
+// Reconcile computes the actual charge for a call from its result.
+// Failed calls are not billed. For media generation, outputs removed by safety
+// filtering are not billed: the estimate is prorated by TotalGenerated/TotalRequested,
+// rounding down so a partial batch is never billed in full.
+func Reconcile(estimate *Estimate, result *results.ToolResult) (*Charge, error) {
+	if estimate == nil || result == nil {
+		return nil, fmt.Errorf("estimate and result are required")
+	}
+	if !sameTool(result.Tool, string(estimate.Tool)) {
+		return nil, fmt.Errorf("result is for tool %s, estimate is for %s", result.Tool, estimate.Tool)
+	}
+
+	charge := &Charge{
+		Estimated: estimate.Credits,
+		Requested: estimate.Units,
+	}
+
+	switch {
+	case !result.Success:
+		charge.Reason = "tool call failed"
+
+	case result.MediaGeneration != nil && result.MediaGeneration.TotalRequested > 0:
+		gen := result.MediaGeneration
+		charge.Requested = gen.TotalRequested
+		charge.Delivered = gen.TotalGenerated
+		if charge.Delivered > charge.Requested {
+			charge.Delivered = charge.Requested
+		}
+		if charge.Delivered < 0 {
+			charge.Delivered = 0
+		}
+		charge.Fraction = float64(charge.Delivered) / float64(charge.Requested)
+		if charge.Delivered < charge.Requested {
+			charge.Reason = fmt.Sprintf("%d of %d outputs were generated", charge.Delivered, charge.Requested)
+		}
+
+	default:
+		charge.Delivered = charge.Requested
+		charge.Fraction = 1
+	}
+
+	charge.Charged = int(math.Floor(float64(estimate.Credits)*charge.Fraction + 1e-9))
+	charge.Refunded = charge.Estimated - charge.Charged
+	return charge, nil
+}
+
+// sameTool reports whether two tool names, either of which may be an alias, name one tool
+func sameTool(a, b string) bool {
+	if a == b {
+		return true
+	}
+	resolvedA, _, okA := tools.ResolveToolName(a)
+	resolvedB, _, okB := tools.ResolveToolName(b)
+	return okA && okB && resolvedA == resolvedB
+}
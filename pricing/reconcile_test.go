@@ -0,0 +1,84 @@
+package pricing
+
+import (
+	"testing"
+
+	"github.com/metaphi-labs/latent-contracts/results"
+	"github.com/metaphi-labs/latent-contracts/tools"
+)
+
+func TestReconcileResolvesResultAlias(t *testing.T) {
+	const alias tools.ToolName = "imagen-reconcile-alias"
+	if err := tools.RegisterAlias(alias, tools.GenerateImageImagen); err != nil {
+		t.Fatal(err)
+	}
+	estimate, err := EstimateParams(string(tools.GenerateImageImagen), map[string]interface{}{"prompt": "a lighthouse", "number_of_images": 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result := &results.ToolResult{Success: true, Tool: string(alias)}
+	charge, err := Reconcile(estimate, result)
+	if err != nil {
+		t.Fatalf("result naming an alias of the estimated tool: %v", err)
+	}
+	if charge.Charged != estimate.Credits {
+		t.Errorf("charged %d, want %d", charge.Charged, estimate.Credits)
+	}
+
+	result.Tool = string(tools.GenerateImageImagenFast)
+	if _, err := Reconcile(estimate, result); err == nil {
+		t.Error("reconciled a result for a different tool")
+	}
+}
+
+func TestReconcileProratesFilteredOutputs(t *testing.T) {
+	estimate := &Estimate{Tool: tools.GenerateImageImagen, Credits: 10, Units: 3}
+	result := &results.ToolResult{
+		Success:         true,
+		Tool:            string(tools.GenerateImageImagen),
+		MediaGeneration: &results.MediaGenerationResult{TotalRequested: 3, TotalGenerated: 2},
+	}
+
+	charge, err := Reconcile(estimate, result)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 10 * 2/3 = 6.67, rounded down
+	if charge.Charged != 6 || charge.Refunded != 4 {
+		t.Errorf("charged %d, refunded %d; want 6 and 4", charge.Charged, charge.Refunded)
+	}
+	if charge.Requested != 3 || charge.Delivered != 2 || charge.Reason == "" {
+		t.Errorf("charge = %+v, want 2 of 3 delivered with a reason", charge)
+	}
+}
+
+func TestReconcileChargesNothingForFailure(t *testing.T) {
+	estimate := &Estimate{Tool: tools.GenerateImageImagen, Credits: 10, Units: 3}
+	result := &results.ToolResult{Success: false, Tool: string(tools.GenerateImageImagen)}
+
+	charge, err := Reconcile(estimate, result)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if charge.Charged != 0 || charge.Refunded != 10 {
+		t.Errorf("charged %d, refunded %d; want 0 and 10", charge.Charged, charge.Refunded)
+	}
+}
+
+func TestReconcileChargesNonMediaResultsInFull(t *testing.T) {
+	estimate := &Estimate{Tool: tools.TrimVideo, Credits: 7, Units: 1}
+	result := &results.ToolResult{
+		Success:         true,
+		Tool:            string(tools.TrimVideo),
+		VideoProcessing: &results.VideoProcessingResult{},
+	}
+
+	charge, err := Reconcile(estimate, result)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if charge.Charged != 7 || charge.Refunded != 0 || charge.Fraction != 1 {
+		t.Errorf("charge = %+v, want the full 7 credits", charge)
+	}
+}
@@ -0,0 +1,50 @@
+package pricing
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/metaphi-labs/latent-contracts/tools"
+)
+
+// RouteIntent picks the cheapest variant that can satisfy the intent, estimating each
+// with the params that express the intent (audio, resolution, outputs). Ties go to the
+// variant listed first in its family. The estimate is for the chosen route's params.
+func RouteIntent(intent tools.Intent) (tools.Route, *Estimate, error) {
+	routes, err := tools.IntentRoutes(intent)
+	if err != nil {
+		return tools.Route{}, nil, err
+	}
+
+	var best tools.Route
+	var bestEstimate *Estimate
+	for _, route := range routes {
+		estimate, err := estimateRoute(route)
+		if err != nil {
+			return tools.Route{}, nil, fmt.Errorf("tool %s: %w", route.Tool, err)
+		}
+		if bestEstimate == nil || estimate.Credits < bestEstimate.Credits {
+			best, bestEstimate = route, estimate
+		}
+	}
+	return best, bestEstimate, nil
+}
+
+// estimateRoute prices a route's params. They lack the prompt and inputs, so they are
+// decoded without validation.
+func estimateRoute(route tools.Route) (*Estimate, error) {
+	def, exists := tools.LookupTool(route.Tool)
+	if !exists {
+		return nil, fmt.Errorf("unknown tool: %s", route.Tool)
+	}
+	raw, err := json.Marshal(route.Params)
+	if err != nil {
+		return nil, err
+	}
+	params := reflect.New(def.ParamsType).Interface()
+	if err := json.Unmarshal(raw, params); err != nil {
+		return nil, err
+	}
+	return EstimateCall(route.Tool, params)
+}
@@ -0,0 +1,59 @@
+package pricing
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/metaphi-labs/latent-contracts/tools"
+)
+
+func TestRouteIntentPicksCheapestEstimate(t *testing.T) {
+	cases := []struct {
+		name   string
+		intent tools.Intent
+	}{
+		{"video, 720p, no audio, from image", tools.Intent{Output: tools.OutputTypeVideo, Resolution: "720p", FromImage: true}},
+		{"video with audio", tools.Intent{Output: tools.OutputTypeVideo, Audio: true}},
+		{"three 1080p videos", tools.Intent{Output: tools.OutputTypeVideo, Resolution: "1080p", Outputs: 3}},
+		{"two 2K images", tools.Intent{Output: tools.OutputTypeImage, ImageSize: "2K", Outputs: 2}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			route, estimate, err := RouteIntent(c.intent)
+			if err != nil {
+				t.Fatal(err)
+			}
+			routes, _ := tools.IntentRoutes(c.intent)
+			for _, other := range routes {
+				otherEstimate, err := estimateRoute(other)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if otherEstimate.Credits < estimate.Credits {
+					t.Errorf("routed to %s at %d credits; %s costs %d", route.Tool, estimate.Credits, other.Tool, otherEstimate.Credits)
+				}
+			}
+		})
+	}
+}
+
+func TestRouteIntentNoAudioFromImage(t *testing.T) {
+	route, estimate, err := RouteIntent(tools.Intent{Output: tools.OutputTypeVideo, Resolution: "720p", FromImage: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	noAudio, _ := tools.GetToolMetadata(tools.GenerateVideoVeo3NoAudio)
+	if estimate.Credits != noAudio.Credits {
+		t.Errorf("routed to %s at %d credits, want the no-audio price %d", route.Tool, estimate.Credits, noAudio.Credits)
+	}
+	if audio, set := route.Params["generate_audio"]; set && audio != false {
+		t.Errorf("route params %v generate audio", route.Params)
+	}
+}
+
+func TestRouteIntentRejectsImpossibleCombination(t *testing.T) {
+	_, _, err := RouteIntent(tools.Intent{Output: tools.OutputTypeImage, Outputs: 5})
+	if err == nil || !strings.Contains(err.Error(), "at most 4") {
+		t.Fatalf("got %v, want an error naming the output limit", err)
+	}
+}
@@ -112,6 +112,9 @@ func NewBatchUpdate(jobID, tool string, totalItems, completedItems, failedItems
 
 // Helper methods
 
+// now is the clock behind the ETA estimates, replaced in tests
+var now = time.Now
+
 // IsTerminal returns true if this is a final status
 func (s Status) IsTerminal() bool {
 	return s == StatusCompleted || s == StatusFailed || s == StatusCancelled
@@ -128,10 +131,45 @@ func (u *Update) CalculateETA() *time.Time {
 		return nil
 	}
 
-	elapsed := time.Since(*u.StartedAt)
+	current := now()
+	elapsed := current.Sub(*u.StartedAt)
 	totalEstimated := time.Duration(float64(elapsed) * (100.0 / float64(u.Progress)))
 	remaining := totalEstimated - elapsed
-	eta := time.Now().Add(remaining)
+	eta := current.Add(remaining)
+
+	return &eta
+}
+
+// CalculateETAWithPrior estimates completion time from an expected total duration
+// (e.g. the tool's typical run time) blended with linear extrapolation from progress.
+// The prior is used alone until progress is reported and its weight shrinks as
+// progress grows. A zero prior falls back to CalculateETA.
+func (u *Update) CalculateETAWithPrior(expected time.Duration) *time.Time {
+	if expected <= 0 {
+		return u.CalculateETA()
+	}
+	if u.Status.IsTerminal() || u.Progress >= 100 {
+		return nil
+	}
+
+	current := now()
+	start := current
+	if u.StartedAt != nil {
+		start = *u.StartedAt
+	}
+	elapsed := current.Sub(start)
 
+	total := expected
+	if u.Progress > 0 {
+		weight := float64(u.Progress) / 100
+		extrapolated := float64(elapsed) * (100.0 / float64(u.Progress))
+		total = time.Duration(weight*extrapolated + (1-weight)*float64(expected))
+	}
+
+	eta := start.Add(total)
+	if eta.Before(current) {
+		// Running past the estimate: expect the remaining share of the prior from now
+		eta = current.Add(time.Duration(float64(expected) * float64(100-u.Progress) / 100))
+	}
 	return &eta
-}
\ No newline at end of file
+}
@@ -0,0 +1,73 @@
+package progress
+
+import (
+	"testing"
+	"time"
+)
+
+var epoch = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+// at fixes the ETA clock for the rest of the test
+func at(t *testing.T, current time.Time) {
+	t.Helper()
+	previous := now
+	now = func() time.Time { return current }
+	t.Cleanup(func() { now = previous })
+}
+
+func running(progress int, started time.Time) *Update {
+	return &Update{Status: StatusProcessing, Progress: progress, StartedAt: &started}
+}
+
+func expectETA(t *testing.T, eta *time.Time, want time.Time) {
+	t.Helper()
+	if eta == nil {
+		t.Fatalf("ETA = nil, want %s", want)
+	}
+	if !eta.Equal(want) {
+		t.Errorf("ETA = %s, want %s", eta.Sub(epoch), want.Sub(epoch))
+	}
+}
+
+func TestETAWithPriorBeforeProgress(t *testing.T) {
+	at(t, epoch.Add(10*time.Second))
+	expectETA(t, running(0, epoch).CalculateETAWithPrior(90*time.Second), epoch.Add(90*time.Second))
+
+	// Without a start time the job is taken to start now
+	update := &Update{Status: StatusQueued}
+	expectETA(t, update.CalculateETAWithPrior(90*time.Second), epoch.Add(100*time.Second))
+}
+
+func TestETAWithPriorBlendsProgress(t *testing.T) {
+	// Half done after 30s extrapolates to 60s; weighted evenly with the 100s prior
+	at(t, epoch.Add(30*time.Second))
+	expectETA(t, running(50, epoch).CalculateETAWithPrior(100*time.Second), epoch.Add(80*time.Second))
+}
+
+func TestETAWithPriorPastEstimate(t *testing.T) {
+	// Five minutes into a one-minute job with no progress reported, the job is given
+	// the remaining share of the prior from now
+	at(t, epoch.Add(5*time.Minute))
+	expectETA(t, running(0, epoch).CalculateETAWithPrior(time.Minute), epoch.Add(6*time.Minute))
+
+	// Once progress is reported the extrapolation alone covers the elapsed time,
+	// so the blend stays ahead of the clock
+	expectETA(t, running(25, epoch).CalculateETAWithPrior(time.Minute), epoch.Add(5*time.Minute+45*time.Second))
+}
+
+func TestETAWithPriorFinished(t *testing.T) {
+	at(t, epoch)
+	if eta := running(100, epoch).CalculateETAWithPrior(time.Minute); eta != nil {
+		t.Errorf("ETA at 100%% = %s, want nil", eta)
+	}
+	done := running(40, epoch)
+	done.Status = StatusCompleted
+	if eta := done.CalculateETAWithPrior(time.Minute); eta != nil {
+		t.Errorf("ETA for a completed job = %s, want nil", eta)
+	}
+}
+
+func TestETAWithoutPriorExtrapolates(t *testing.T) {
+	at(t, epoch.Add(20*time.Second))
+	expectETA(t, running(25, epoch).CalculateETAWithPrior(0), epoch.Add(80*time.Second))
+}
@@ -0,0 +1,169 @@
+// Package ratelimit enforces the per-tool tools.RateLimitPolicy: a token bucket for
+// each user's calls per minute, and semaphores for jobs in flight per user and overall.
+// Hitting a limit returns errors.RateLimitError with the time until a retry can succeed;
+// for concurrency limits that time is an estimate based on the tool's typical duration.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/metaphi-labs/latent-contracts/errors"
+	"github.com/metaphi-labs/latent-contracts/tools"
+)
+
+// DefaultConcurrencyRetry is the RetryAfter reported when a concurrency limit is full
+// and the tool declares no typical job duration.
+const DefaultConcurrencyRetry = 10 * time.Second
+
+// Limiter checks tool calls against their tools' rate-limit policies
+type Limiter struct {
+	store            Store
+	service          string
+	now              func() time.Time
+	concurrencyRetry time.Duration
+}
+
+// Option configures a Limiter
+type Option func(*Limiter)
+
+// WithService sets the service named in rate-limit errors
+func WithService(service string) Option {
+	return func(l *Limiter) {
+		l.service = service
+	}
+}
+
+// WithClock replaces time.Now, for tests
+func WithClock(now func() time.Time) Option {
+	return func(l *Limiter) {
+		l.now = now
+	}
+}
+
+// WithConcurrencyRetry sets a fixed RetryAfter for full concurrency limits, instead of
+// each tool's typical job duration
+func WithConcurrencyRetry(d time.Duration) Option {
+	return func(l *Limiter) {
+		l.concurrencyRetry = d
+	}
+}
+
+// NewLimiter creates a limiter keeping its state in store
+func NewLimiter(store Store, opts ...Option) *Limiter {
+	l := &Limiter{store: store, service: "rate-limiter", now: time.Now}
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l
+}
+
+// Permit holds the concurrency slots of an admitted call until released
+type Permit struct {
+	limiter *Limiter
+	keys    []string
+	once    sync.Once
+}
+
+// Release frees the permit's slots once the job has finished. It is safe to call
+// more than once and on a nil permit.
+func (p *Permit) Release(ctx context.Context) error {
+	if p == nil {
+		return nil
+	}
+	var err error
+	p.once.Do(func() {
+		err = p.limiter.release(ctx, p.keys)
+	})
+	return err
+}
+
+// Acquire admits a call by a user or returns a RATE_LIMIT_EXCEEDED ServiceError.
+// Concurrency slots are taken before the per-minute token so a rejected call never
+// spends one. The returned permit must be released when the job finishes.
+func (l *Limiter) Acquire(ctx context.Context, userID string, toolName string) (*Permit, error) {
+	name, _, exists := tools.ResolveToolName(toolName)
+	if !exists {
+		return nil, fmt.Errorf("unknown tool: %s", toolName)
+	}
+	meta, _ := tools.GetToolMetadata(name)
+	policy := meta.RateLimit
+	permit := &Permit{limiter: l}
+	if !policy.Limited() {
+		return permit, nil
+	}
+	group := policy.Key(name)
+
+	slots := []struct {
+		key   string
+		max   int
+		limit string
+	}{
+		{"concurrent:" + group + ":user:" + userID, policy.UserConcurrent,
+			fmt.Sprintf("%d %s jobs per user at once", policy.UserConcurrent, group)},
+		{"concurrent:" + group, policy.GlobalConcurrent,
+			fmt.Sprintf("%d %s jobs at once across all users", policy.GlobalConcurrent, group)},
+	}
+	for _, slot := range slots {
+		if slot.max <= 0 {
+			continue
+		}
+		ok, err := l.store.Acquire(ctx, slot.key, slot.max)
+		if err != nil {
+			l.release(ctx, permit.keys)
+			return nil, err
+		}
+		if !ok {
+			l.release(ctx, permit.keys)
+			return nil, l.concurrencyError(meta.Duration, slot.limit)
+		}
+		permit.keys = append(permit.keys, slot.key)
+	}
+
+	if policy.UserRPM > 0 {
+		ok, retryAfter, err := l.store.TakeToken(ctx, "rpm:"+group+":user:"+userID, policy.UserRPM, time.Minute, l.now())
+		if err != nil {
+			l.release(ctx, permit.keys)
+			return nil, err
+		}
+		if !ok {
+			l.release(ctx, permit.keys)
+			return nil, l.limitError(retryAfter, fmt.Sprintf("%d %s calls per user per minute", policy.UserRPM, group))
+		}
+	}
+	return permit, nil
+}
+
+func (l *Limiter) release(ctx context.Context, keys []string) error {
+	var first error
+	for _, key := range keys {
+		if err := l.store.Release(ctx, key); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+// concurrencyError builds the RateLimitError for a full concurrency limit. Slots free up
+// when running jobs finish, which the limiter can't observe, so RetryAfter is a hint:
+// the tool's typical job duration unless WithConcurrencyRetry fixed one.
+func (l *Limiter) concurrencyError(duration tools.ExpectedDuration, limit string) *errors.ServiceError {
+	retryAfter := l.concurrencyRetry
+	if retryAfter <= 0 {
+		retryAfter = duration.Typical
+	}
+	if retryAfter <= 0 {
+		retryAfter = DefaultConcurrencyRetry
+	}
+	return l.limitError(retryAfter, limit+"; retry after is an estimate of when a running job finishes")
+}
+
+// limitError builds the RateLimitError for a limit, RetryAfter rounded up to the millisecond
+func (l *Limiter) limitError(retryAfter time.Duration, limit string) *errors.ServiceError {
+	if rounded := retryAfter.Truncate(time.Millisecond); rounded < retryAfter {
+		retryAfter = rounded + time.Millisecond
+	}
+	return errors.RateLimitError(l.service, retryAfter).WithCause(fmt.Errorf("limit of %s reached", limit))
+}
@@ -0,0 +1,155 @@
+package ratelimit
+
+import (
+	"context"
+	stderrors "errors"
+	"testing"
+	"time"
+
+	"github.com/metaphi-labs/latent-contracts/errors"
+	"github.com/metaphi-labs/latent-contracts/tools"
+)
+
+// clock is a settable time source for WithClock
+type clock struct {
+	now time.Time
+}
+
+func newClock() *clock {
+	return &clock{now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func (c *clock) Now() time.Time {
+	return c.now
+}
+
+func (c *clock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+// veo3 asks for a generate-video-veo3 call, limited to 4/min and 2 at once per user
+func veo3(l *Limiter) (*Permit, error) {
+	return l.Acquire(context.Background(), "user", string(tools.GenerateVideoVeo3))
+}
+
+func release(t *testing.T, p *Permit) {
+	t.Helper()
+	must(t, p.Release(context.Background()))
+}
+
+func must(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// retryAfter returns the RetryAfter of a RATE_LIMIT_EXCEEDED error
+func retryAfter(t *testing.T, err error) time.Duration {
+	t.Helper()
+	var serviceErr *errors.ServiceError
+	if !stderrors.As(err, &serviceErr) || serviceErr.Code != errors.RATE_LIMIT_EXCEEDED {
+		t.Fatalf("error = %v, want %s", err, errors.RATE_LIMIT_EXCEEDED)
+	}
+	if serviceErr.Metadata == nil || serviceErr.Metadata.RetryAfter == nil {
+		t.Fatal("rate limit error has no RetryAfter")
+	}
+	return *serviceErr.Metadata.RetryAfter
+}
+
+func TestUserRPMRetryAfter(t *testing.T) {
+	c := newClock()
+	l := NewLimiter(NewMemoryStore(), WithClock(c.Now))
+
+	// 4 per minute: four calls a second apart use the bucket up
+	for i := 0; i < 4; i++ {
+		p, err := veo3(l)
+		must(t, err)
+		release(t, p)
+		c.Advance(time.Second)
+	}
+
+	_, err := veo3(l)
+	if got := retryAfter(t, err); got != 11*time.Second {
+		t.Errorf("RetryAfter = %v, want 11s", got)
+	}
+
+	c.Advance(11 * time.Second)
+	p, err := veo3(l)
+	if err != nil {
+		t.Fatalf("call after RetryAfter: %v", err)
+	}
+	release(t, p)
+}
+
+func TestConcurrencyReleasesSlots(t *testing.T) {
+	c := newClock()
+	store := NewMemoryStore()
+	l := NewLimiter(store, WithClock(c.Now))
+
+	first, err := veo3(l)
+	must(t, err)
+	second, err := veo3(l)
+	must(t, err)
+
+	_, err = veo3(l)
+	meta, _ := tools.GetToolMetadata(tools.GenerateVideoVeo3)
+	if got := retryAfter(t, err); got != meta.Duration.Typical {
+		t.Errorf("RetryAfter = %v, want the typical duration %v", got, meta.Duration.Typical)
+	}
+
+	release(t, first)
+	release(t, first) // Releasing twice frees one slot
+	if got := store.InFlight("concurrent:veo3:user:user"); got != 1 {
+		t.Errorf("user slots in flight = %d, want 1", got)
+	}
+	third, err := veo3(l)
+	if err != nil {
+		t.Fatalf("call after a release: %v", err)
+	}
+	release(t, second)
+	release(t, third)
+	if got := store.InFlight("concurrent:veo3"); got != 0 {
+		t.Errorf("global slots in flight = %d, want 0", got)
+	}
+}
+
+func TestRejectedTokenRollsBackSlots(t *testing.T) {
+	c := newClock()
+	store := NewMemoryStore()
+	l := NewLimiter(store, WithClock(c.Now))
+
+	for i := 0; i < 4; i++ {
+		p, err := veo3(l)
+		must(t, err)
+		release(t, p)
+	}
+	if _, err := veo3(l); err == nil {
+		t.Fatal("fifth call in a minute was admitted")
+	}
+	for _, key := range []string{"concurrent:veo3:user:user", "concurrent:veo3"} {
+		if got := store.InFlight(key); got != 0 {
+			t.Errorf("%s: %d slots still taken after the rejected call", key, got)
+		}
+	}
+}
+
+func TestWithConcurrencyRetryOverridesEstimate(t *testing.T) {
+	l := NewLimiter(NewMemoryStore(), WithConcurrencyRetry(3*time.Second))
+	for i := 0; i < 2; i++ {
+		_, err := veo3(l)
+		must(t, err)
+	}
+	if _, err := veo3(l); retryAfter(t, err) != 3*time.Second {
+		t.Errorf("RetryAfter = %v, want 3s", retryAfter(t, err))
+	}
+}
+
+func TestUnlimitedToolIsAdmitted(t *testing.T) {
+	l := NewLimiter(NewMemoryStore())
+	for i := 0; i < 100; i++ {
+		p, err := l.Acquire(context.Background(), "user", string(tools.GoogleSearch))
+		must(t, err)
+		release(t, p)
+	}
+}
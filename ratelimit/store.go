@@ -0,0 +1,107 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// Store keeps limiter state. Implementations backed by a shared cache (e.g. Redis)
+// let several service replicas enforce one set of limits; MemoryStore covers a
+// single process and tests.
+type Store interface {
+	// TakeToken removes one token from the bucket at key, which holds up to capacity
+	// tokens and refills at capacity per interval. When the bucket is empty it takes
+	// nothing and returns how long until a token is available.
+	TakeToken(ctx context.Context, key string, capacity int, interval time.Duration, now time.Time) (ok bool, retryAfter time.Duration, err error)
+
+	// Acquire takes one of max slots at key, returning false when all are taken
+	Acquire(ctx context.Context, key string, max int) (bool, error)
+
+	// Release frees a slot taken by Acquire
+	Release(ctx context.Context, key string) error
+}
+
+// MemoryStore is an in-process Store
+type MemoryStore struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	slots   map[string]int
+}
+
+type bucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+// NewMemoryStore creates an empty in-process store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{buckets: make(map[string]*bucket), slots: make(map[string]int)}
+}
+
+// TakeToken implements Store
+func (s *MemoryStore) TakeToken(ctx context.Context, key string, capacity int, interval time.Duration, now time.Time) (bool, time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rate := float64(capacity) / float64(interval) // Tokens per nanosecond
+	b, exists := s.buckets[key]
+	if !exists {
+		b = &bucket{tokens: float64(capacity), updated: now}
+		s.buckets[key] = b
+	}
+	if elapsed := now.Sub(b.updated); elapsed > 0 {
+		b.tokens = math.Min(float64(capacity), b.tokens+float64(elapsed)*rate)
+		b.updated = now
+	}
+
+	if b.tokens < 1 {
+		wait := time.Duration(math.Ceil((1 - b.tokens) / rate))
+		return false, wait, nil
+	}
+	b.tokens--
+	return true, 0, nil
+}
+
+// Acquire implements Store
+func (s *MemoryStore) Acquire(ctx context.Context, key string, max int) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.slots[key] >= max {
+		return false, nil
+	}
+	s.slots[key]++
+	return true, nil
+}
+
+// Release implements Store
+func (s *MemoryStore) Release(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.slots[key] <= 1 {
+		delete(s.slots, key)
+		return nil
+	}
+	s.slots[key]--
+	return nil
+}
+
+// InFlight returns the slots taken at key
+func (s *MemoryStore) InFlight(key string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.slots[key]
+}
+
+// Prune drops buckets that have refilled completely by now, which behave exactly
+// like missing ones. Call it periodically in long-running processes with many users.
+func (s *MemoryStore) Prune(now time.Time, interval time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key, b := range s.buckets {
+		if now.Sub(b.updated) >= interval {
+			delete(s.buckets, key)
+		}
+	}
+}
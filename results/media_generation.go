@@ -1,6 +1,7 @@
 package results
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -42,6 +43,9 @@ type MediaGenerationResult struct {
 	TotalRequested  int    `json:"total_requested"`
 	TotalGenerated  int    `json:"total_generated"`
 
+	// Parameters the tool actually ran with, defaults included (see tools.Normalize)
+	Parameters map[string]interface{} `json:"parameters,omitempty"`
+
 }
 
 // Constructor functions for Media AI service to use
@@ -179,6 +183,20 @@ func (m *MediaGenerationResult) Validate() error {
 	return nil
 }
 
+// SetParameters records the normalized params struct the tool ran with
+func (m *MediaGenerationResult) SetParameters(params interface{}) error {
+	raw, err := json.Marshal(params)
+	if err != nil {
+		return fmt.Errorf("failed to marshal parameters: %w", err)
+	}
+	var parameters map[string]interface{}
+	if err := json.Unmarshal(raw, &parameters); err != nil {
+		return fmt.Errorf("parameters must be a JSON object: %w", err)
+	}
+	m.Parameters = parameters
+	return nil
+}
+
 // Helper to create execution metadata for Media AI
 func NewMediaAIMetadata(
 	startTime time.Time,
@@ -0,0 +1,169 @@
+package tools
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/metaphi-labs/latent-contracts/errors"
+)
+
+// Tier is a subscription plan
+type Tier string
+
+const (
+	TierFree       Tier = "free"
+	TierPro        Tier = "pro"
+	TierEnterprise Tier = "enterprise"
+)
+
+// tierRank orders tiers; a tool's MinTier admits its own tier and every higher one
+var tierRank = map[Tier]int{
+	TierFree:       0,
+	TierPro:        1,
+	TierEnterprise: 2,
+}
+
+// Availability restricts who may call a tool. The zero value allows everyone;
+// every rule that is set must pass.
+type Availability struct {
+	MinTier        Tier     // Lowest tier allowed
+	Regions        []string // Only callers in these regions; region codes compare case-insensitively
+	BlockedRegions []string // Never callers in these regions
+	Flag           string   // Feature flag the caller must have enabled, for staged rollouts
+	Orgs           []string // Only these organizations
+}
+
+// Restricted reports whether any rule is set
+func (a Availability) Restricted() bool {
+	return a.MinTier != "" || len(a.Regions) > 0 || len(a.BlockedRegions) > 0 || a.Flag != "" || len(a.Orgs) > 0
+}
+
+// Describe summarizes the rules for docs, e.g. "pro tier or higher; feature flag veo-beta"
+func (a Availability) Describe() string {
+	var parts []string
+	if a.MinTier != "" {
+		parts = append(parts, fmt.Sprintf("%s tier or higher", a.MinTier))
+	}
+	if len(a.Regions) > 0 {
+		parts = append(parts, "regions "+strings.Join(a.Regions, ", "))
+	}
+	if len(a.BlockedRegions) > 0 {
+		parts = append(parts, "not in "+strings.Join(a.BlockedRegions, ", "))
+	}
+	if a.Flag != "" {
+		parts = append(parts, "feature flag "+a.Flag)
+	}
+	if len(a.Orgs) > 0 {
+		parts = append(parts, fmt.Sprintf("%d organizations", len(a.Orgs)))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// CallerContext describes who is calling a tool
+type CallerContext struct {
+	Tier   Tier            `json:"tier,omitempty"` // Empty means free
+	Region string          `json:"region,omitempty"`
+	Flags  map[string]bool `json:"flags,omitempty"` // Enabled feature flags
+	OrgID  string          `json:"org_id,omitempty"`
+}
+
+// HasFlag reports whether a feature flag is enabled for the caller
+func (c CallerContext) HasFlag(flag string) bool {
+	return c.Flags[flag]
+}
+
+// Denial returns why the rules reject a caller, or "" when the caller is allowed
+func (a Availability) Denial(tool ToolName, caller CallerContext) string {
+	if a.MinTier != "" {
+		tier := caller.Tier
+		if tier == "" {
+			tier = TierFree
+		}
+		rank, known := tierRank[tier]
+		if !known || rank < tierRank[a.MinTier] {
+			return fmt.Sprintf("Tool '%s' requires the %s tier or higher; the caller is on %s", tool, a.MinTier, tier)
+		}
+	}
+	if len(a.Regions) > 0 && !containsFold(a.Regions, caller.Region) {
+		return fmt.Sprintf("Tool '%s' is not available in region '%s'", tool, caller.Region)
+	}
+	if containsFold(a.BlockedRegions, caller.Region) {
+		return fmt.Sprintf("Tool '%s' is not available in region '%s'", tool, caller.Region)
+	}
+	if a.Flag != "" && !caller.HasFlag(a.Flag) {
+		return fmt.Sprintf("Tool '%s' requires feature flag '%s', which is not enabled for the caller", tool, a.Flag)
+	}
+	if len(a.Orgs) > 0 && !containsString(a.Orgs, caller.OrgID) {
+		return fmt.Sprintf("Tool '%s' is not available to organization '%s'", tool, caller.OrgID)
+	}
+	return ""
+}
+
+// CheckAvailability returns nil if the caller may use a tool, or an AUTH_FORBIDDEN
+// ServiceError whose message says which rule failed. Aliases resolve to their tool.
+func CheckAvailability(toolName string, caller CallerContext) error {
+	name, _, exists := ResolveToolName(toolName)
+	if !exists {
+		return fmt.Errorf("unknown tool: %s", toolName)
+	}
+	meta, _ := GetToolMetadata(name)
+	if err := availabilityError(meta, caller); err != nil {
+		return err
+	}
+	return nil
+}
+
+// availabilityError returns the AUTH_FORBIDDEN error for a caller the tool's rules reject
+func availabilityError(meta ToolMeta, caller CallerContext) *errors.ServiceError {
+	if reason := meta.Availability.Denial(meta.Name, caller); reason != "" {
+		return errors.NewServiceError(errors.AUTH_FORBIDDEN, reason, "tool-availability", false)
+	}
+	return nil
+}
+
+// AllowedTools returns the tools a caller may use, in registration order
+func AllowedTools(caller CallerContext) []ToolName {
+	var allowed []ToolName
+	for _, meta := range allToolMeta() {
+		if meta.Availability.Denial(meta.Name, caller) == "" {
+			allowed = append(allowed, meta.Name)
+		}
+	}
+	return allowed
+}
+
+// ToolFilter selects tools for the listing functions (GetAllSchemas, GetGeminiDeclarations...)
+type ToolFilter func(ToolName) bool
+
+// AvailableTo keeps only the tools a caller may use
+func AvailableTo(caller CallerContext) ToolFilter {
+	return func(name ToolName) bool {
+		meta, exists := GetToolMetadata(name)
+		return exists && meta.Availability.Denial(name, caller) == ""
+	}
+}
+
+// ForCaller makes DecodeParams fail with AUTH_FORBIDDEN when the caller may not use the tool
+func ForCaller(caller CallerContext) DecodeOption {
+	return func(c *decodeConfig) {
+		c.caller = &caller
+	}
+}
+
+// passes reports whether a tool passes every filter
+func passes(name ToolName, filters []ToolFilter) bool {
+	for _, filter := range filters {
+		if !filter(name) {
+			return false
+		}
+	}
+	return true
+}
+
+// validateAvailability checks the rules of a tool being registered
+func validateAvailability(a Availability) error {
+	if _, known := tierRank[a.MinTier]; a.MinTier != "" && !known {
+		return fmt.Errorf("unknown tier %q", a.MinTier)
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package tools
+
+import (
+	stderrors "errors"
+	"strings"
+	"testing"
+
+	"github.com/metaphi-labs/latent-contracts/errors"
+)
+
+func TestAvailabilityDenial(t *testing.T) {
+	cases := []struct {
+		name   string
+		rules  Availability
+		caller CallerContext
+		denied string // Part of the denial, or "" when allowed
+	}{
+		{"no rules", Availability{}, CallerContext{}, ""},
+		{"tier met", Availability{MinTier: TierPro}, CallerContext{Tier: TierPro}, ""},
+		{"higher tier", Availability{MinTier: TierPro}, CallerContext{Tier: TierEnterprise}, ""},
+		{"lower tier", Availability{MinTier: TierPro}, CallerContext{Tier: TierFree}, "requires the pro tier"},
+		{"empty tier is free", Availability{MinTier: TierPro}, CallerContext{}, "the caller is on free"},
+		{"free tier admits empty", Availability{MinTier: TierFree}, CallerContext{}, ""},
+		{"unknown tier", Availability{MinTier: TierFree}, CallerContext{Tier: "platinum"}, "requires the free tier"},
+		{"allowed region", Availability{Regions: []string{"us", "eu"}}, CallerContext{Region: "EU"}, ""},
+		{"other region", Availability{Regions: []string{"us"}}, CallerContext{Region: "eu"}, "not available in region 'eu'"},
+		{"no region", Availability{Regions: []string{"us"}}, CallerContext{}, "not available in region"},
+		{"blocked region", Availability{BlockedRegions: []string{"xx"}}, CallerContext{Region: "XX"}, "not available in region 'XX'"},
+		{"unblocked region", Availability{BlockedRegions: []string{"xx"}}, CallerContext{Region: "us"}, ""},
+		{"flag on", Availability{Flag: "beta"}, CallerContext{Flags: map[string]bool{"beta": true}}, ""},
+		{"flag off", Availability{Flag: "beta"}, CallerContext{Flags: map[string]bool{"beta": false}}, "feature flag 'beta'"},
+		{"listed org", Availability{Orgs: []string{"acme"}}, CallerContext{OrgID: "acme"}, ""},
+		{"other org", Availability{Orgs: []string{"acme"}}, CallerContext{OrgID: "initech"}, "organization 'initech'"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			denial := tc.rules.Denial("tool", tc.caller)
+			switch {
+			case tc.denied == "" && denial != "":
+				t.Errorf("denied: %s", denial)
+			case tc.denied != "" && !strings.Contains(denial, tc.denied):
+				t.Errorf("denial %q, want it to mention %q", denial, tc.denied)
+			}
+		})
+	}
+}
+
+func TestForCallerRejectsUnavailableTool(t *testing.T) {
+	params := map[string]interface{}{"prompt": "waves against a pier"}
+
+	_, err := DecodeParams(string(GenerateVideoVeo3Fast), params, ForCaller(CallerContext{Tier: TierFree}))
+	var serviceErr *errors.ServiceError
+	if !stderrors.As(err, &serviceErr) || serviceErr.Code != errors.AUTH_FORBIDDEN {
+		t.Fatalf("error = %v, want %s", err, errors.AUTH_FORBIDDEN)
+	}
+
+	if _, err := DecodeParams(string(GenerateVideoVeo3Fast), params, ForCaller(CallerContext{Tier: TierPro})); err != nil {
+		t.Errorf("pro caller: %v", err)
+	}
+}
+
+func TestAvailableToFiltersListings(t *testing.T) {
+	free := AvailableTo(CallerContext{Tier: TierFree})
+
+	schemas := GetAllSchemas(free)
+	if _, exists := schemas[GenerateVideoVeo3]; exists {
+		t.Error("schemas for a free caller include a pro tool")
+	}
+	if _, exists := schemas[GenerateImageImagen]; !exists {
+		t.Error("schemas for a free caller are missing a free tool")
+	}
+	if all := GetAllSchemas(); len(all) <= len(schemas) {
+		t.Errorf("unfiltered schemas (%d) should outnumber the free caller's (%d)", len(all), len(schemas))
+	}
+
+	decls, err := GetGeminiDeclarations(free)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, decl := range decls {
+		meta, _ := GetToolMetadata(ToolName(decl.Name))
+		if meta.Availability.MinTier == TierPro {
+			t.Errorf("declarations for a free caller include %s", decl.Name)
+		}
+	}
+	if len(decls) == 0 {
+		t.Error("no declarations for a free caller")
+	}
+}
@@ -0,0 +1,235 @@
+package tools
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/metaphi-labs/latent-contracts/types"
+)
+
+// MediaInput is one media-typed field of a tool's params, derived from its types.Input* fields
+type MediaInput struct {
+	Field string     `json:"field"` // JSON path; "[]" marks list items, e.g. "videos[]"
+	Kind  OutputType `json:"kind"`
+	Min   int        `json:"min"`           // Media of this field a valid call must include
+	Max   int        `json:"max,omitempty"` // 0 means no limit
+}
+
+// Capabilities describes what media a tool consumes and produces
+type Capabilities struct {
+	Tool       ToolName     `json:"tool"`
+	Inputs     []MediaInput `json:"inputs,omitempty"`
+	Output     OutputType   `json:"output"`
+	Service    ServiceType  `json:"service"`
+	Credits    int          `json:"credits"`
+	Deprecated bool         `json:"deprecated,omitempty"`
+}
+
+// InputRange returns how many media of a kind a call takes across all fields.
+// max is 0 when unlimited; both are 0 when the tool doesn't accept the kind.
+func (c Capabilities) InputRange(kind OutputType) (min, max int) {
+	unlimited := false
+	for _, in := range c.Inputs {
+		if in.Kind != kind {
+			continue
+		}
+		min += in.Min
+		if in.Max == 0 {
+			unlimited = true
+		}
+		max += in.Max
+	}
+	if unlimited {
+		max = 0
+	}
+	return min, max
+}
+
+// Accepts reports whether the tool takes media of a kind
+func (c Capabilities) Accepts(kind OutputType) bool {
+	for _, in := range c.Inputs {
+		if in.Kind == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// CanRunWith reports whether assets (counts by kind) cover every media the tool requires
+func (c Capabilities) CanRunWith(assets map[OutputType]int) bool {
+	for _, kind := range []OutputType{OutputTypeImage, OutputTypeVideo, OutputTypeAudio} {
+		if min, _ := c.InputRange(kind); assets[kind] < min {
+			return false
+		}
+	}
+	return true
+}
+
+// GetCapabilities derives a registered tool's capabilities from its params struct and metadata
+func GetCapabilities(name ToolName) (Capabilities, bool) {
+	def, exists := LookupTool(name)
+	if !exists {
+		return Capabilities{}, false
+	}
+	return Capabilities{
+		Tool:       def.Name,
+		Inputs:     mediaInputs(def.ParamsType, "", 1, 1),
+		Output:     def.Meta.OutputType,
+		Service:    def.Meta.ServiceType,
+		Credits:    def.Meta.Credits,
+		Deprecated: IsDeprecated(def.Name),
+	}, true
+}
+
+// AllCapabilities returns the capabilities of every registered tool in registration order
+func AllCapabilities() []Capabilities {
+	var all []Capabilities
+	for _, name := range RegisteredTools() {
+		if c, ok := GetCapabilities(name); ok {
+			all = append(all, c)
+		}
+	}
+	return all
+}
+
+// CapabilityQuery filters tools by what they consume and produce.
+// Zero-valued fields don't filter.
+type CapabilityQuery struct {
+	Accepts           []OutputType       // The tool must take every one of these kinds
+	Assets            map[OutputType]int // Media on hand; the tool's required inputs must fit within these counts
+	Produces          []OutputType       // The tool must output one of these kinds
+	Service           ServiceType
+	MaxCredits        int // Base credits ceiling (ToolMeta.Credits)
+	IncludeDeprecated bool
+}
+
+// FindTools returns the capabilities of every tool matching the query, in registration order
+func FindTools(q CapabilityQuery) []Capabilities {
+	var matches []Capabilities
+	for _, c := range AllCapabilities() {
+		if q.matches(c) {
+			matches = append(matches, c)
+		}
+	}
+	return matches
+}
+
+// ConsumersOf returns the tools that can take the output of a tool as an input,
+// e.g. the image from extract-frame
+func ConsumersOf(name ToolName) []Capabilities {
+	meta, exists := GetToolMetadata(name)
+	if !exists {
+		return nil
+	}
+	switch meta.OutputType {
+	case OutputTypeImage, OutputTypeVideo, OutputTypeAudio:
+		return FindTools(CapabilityQuery{Accepts: []OutputType{meta.OutputType}})
+	}
+	return nil
+}
+
+func (q CapabilityQuery) matches(c Capabilities) bool {
+	if c.Deprecated && !q.IncludeDeprecated {
+		return false
+	}
+	if q.Service != "" && c.Service != q.Service {
+		return false
+	}
+	if q.MaxCredits > 0 && c.Credits > q.MaxCredits {
+		return false
+	}
+	if len(q.Produces) > 0 && !containsOutput(q.Produces, c.Output) {
+		return false
+	}
+	for _, kind := range q.Accepts {
+		if !c.Accepts(kind) {
+			return false
+		}
+	}
+	if q.Assets != nil && !c.CanRunWith(q.Assets) {
+		return false
+	}
+	return true
+}
+
+func containsOutput(list []OutputType, kind OutputType) bool {
+	for _, k := range list {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+var inputMediaKinds = map[reflect.Type]OutputType{
+	reflect.TypeOf(types.InputImage{}): OutputTypeImage,
+	reflect.TypeOf(types.InputVideo{}): OutputTypeVideo,
+	reflect.TypeOf(types.InputAudio{}): OutputTypeAudio,
+}
+
+// mediaInputs finds the types.Input* fields of a struct type. min and max are the
+// cardinality of the struct itself (max 0 = unlimited) and scale what is found inside.
+func mediaInputs(t reflect.Type, path string, min, max int) []MediaInput {
+	var found []MediaInput
+	for _, field := range jsonFields(derefType(t)) {
+		outer, _, _ := splitDive(fieldValidateRules(field))
+		fieldPath := joinPath(path, field.JSONName)
+		fieldMin, fieldMax := fieldCardinality(field.Type, outer)
+
+		elem := derefType(field.Type)
+		if k := elem.Kind(); k == reflect.Slice || k == reflect.Array {
+			elem = derefType(elem.Elem())
+			fieldPath += "[]"
+		}
+		totalMin, totalMax := min*fieldMin, max*fieldMax
+
+		if kind, ok := inputMediaKinds[elem]; ok {
+			found = append(found, MediaInput{Field: fieldPath, Kind: kind, Min: totalMin, Max: totalMax})
+			continue
+		}
+		if elem.Kind() == reflect.Struct {
+			found = append(found, mediaInputs(elem, fieldPath, totalMin, totalMax)...)
+		}
+	}
+	return found
+}
+
+// fieldCardinality reads how many values a field holds from its type and validate rules
+func fieldCardinality(t reflect.Type, rules []string) (min, max int) {
+	required := ruleIn(rules, "required")
+	t = derefType(t)
+	if t.Kind() != reflect.Slice && t.Kind() != reflect.Array {
+		if required {
+			return 1, 1
+		}
+		return 0, 1
+	}
+	if t.Kind() == reflect.Array {
+		min, max = t.Len(), t.Len()
+	}
+
+	for _, rule := range rules {
+		tag, param, _ := strings.Cut(rule, "=")
+		n, err := strconv.Atoi(param)
+		if err != nil {
+			continue
+		}
+		switch tag {
+		case "min", "gte":
+			min = n
+		case "max", "lte":
+			max = n
+		case "len":
+			min, max = n, n
+		}
+	}
+	// omitempty lets an empty list through whatever its minimum
+	if ruleIn(rules, "omitempty") && !required {
+		min = 0
+	}
+	if required && min == 0 {
+		min = 1
+	}
+	return min, max
+}
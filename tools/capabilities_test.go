@@ -0,0 +1,74 @@
+package tools
+
+import "testing"
+
+func capabilityNames(caps []Capabilities) map[ToolName]bool {
+	names := make(map[ToolName]bool, len(caps))
+	for _, c := range caps {
+		names[c.Tool] = true
+	}
+	return names
+}
+
+func TestFindToolsByMediaKinds(t *testing.T) {
+	found := capabilityNames(FindTools(CapabilityQuery{
+		Accepts:  []OutputType{OutputTypeImage},
+		Produces: []OutputType{OutputTypeVideo},
+	}))
+	for _, name := range []ToolName{ImagesToVideo, ImageAudioMerge, GenerateVideoVeo3} {
+		if !found[name] {
+			t.Errorf("%s takes an image and produces a video but was not found", name)
+		}
+	}
+	for _, name := range []ToolName{GenerateVideoVeo3Fast, MergeImages, TrimVideo} {
+		if found[name] {
+			t.Errorf("%s was found but does not turn images into video", name)
+		}
+	}
+}
+
+func TestFindToolsWithAssets(t *testing.T) {
+	// One image and no audio is enough for images-to-video but not image-audio-merge
+	found := capabilityNames(FindTools(CapabilityQuery{
+		Assets:   map[OutputType]int{OutputTypeImage: 1},
+		Produces: []OutputType{OutputTypeVideo},
+	}))
+	if !found[ImagesToVideo] {
+		t.Errorf("%s can run with one image", ImagesToVideo)
+	}
+	if found[ImageAudioMerge] {
+		t.Errorf("%s needs audio", ImageAudioMerge)
+	}
+}
+
+func TestConsumersOf(t *testing.T) {
+	consumers := capabilityNames(ConsumersOf(ExtractFrame))
+	for _, name := range []ToolName{ImagesToVideo, MergeImages, NanoBanana} {
+		if !consumers[name] {
+			t.Errorf("%s takes images but is not a consumer of %s", name, ExtractFrame)
+		}
+	}
+	if consumers[TrimVideo] {
+		t.Errorf("%s takes no images but is a consumer of %s", TrimVideo, ExtractFrame)
+	}
+
+	if consumers := ConsumersOf(GoogleSearch); consumers != nil {
+		t.Errorf("%s produces no media but has consumers %v", GoogleSearch, consumers)
+	}
+}
+
+func TestCapabilitiesInputs(t *testing.T) {
+	caps, exists := GetCapabilities(ImagesToVideo)
+	if !exists {
+		t.Fatalf("%s has no capabilities", ImagesToVideo)
+	}
+	if min, max := caps.InputRange(OutputTypeImage); min != 1 || max != 100 {
+		t.Errorf("image range = %d..%d, want 1..100", min, max)
+	}
+	if min, max := caps.InputRange(OutputTypeAudio); min != 0 || max != 1 {
+		t.Errorf("audio range = %d..%d, want 0..1", min, max)
+	}
+	if caps.CanRunWith(map[OutputType]int{OutputTypeAudio: 1}) {
+		t.Error("runs without the required images")
+	}
+}
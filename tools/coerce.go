@@ -0,0 +1,265 @@
+package tools
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// WarningCoerced reports a value Lenient decoding changed to make it valid
+const WarningCoerced WarningCode = "coerced"
+
+// Lenient makes DecodeParams repair the near-misses models commonly send before
+// validating: numeric and boolean strings, integral floats, mis-cased or
+// mis-separated keys and enum values ("aspectRatio", "Fade", "16x9"), scalars where
+// a list is expected, and out-of-range values on fields tagged `lenient:"clamp"`.
+// Every change is reported as a WarningCoerced warning (see CollectWarnings).
+// Values that can't be repaired are left alone and fail validation as usual.
+func Lenient() DecodeOption {
+	return func(c *decodeConfig) {
+		c.lenient = true
+	}
+}
+
+// coerceParams returns a repaired copy of raw params for the struct type t
+func coerceParams(params map[string]interface{}, t reflect.Type, warnings *[]Warning) map[string]interface{} {
+	out, _ := coerceValue("", params, derefType(t), nil, false, warnings).(map[string]interface{})
+	return out
+}
+
+// coerceValue repairs one raw value for a Go type. rules are the validate rules that
+// apply to the value and clamp reports whether the field allows clamping to its bounds.
+func coerceValue(path string, value interface{}, t reflect.Type, rules []string, clamp bool, warnings *[]Warning) interface{} {
+	if value == nil {
+		return nil
+	}
+	t = derefType(t)
+
+	changed := func(to interface{}, how string) interface{} {
+		*warnings = append(*warnings, Warning{
+			Code:    WarningCoerced,
+			Field:   path,
+			Message: fmt.Sprintf("Parameter '%s' %s: %s -> %s", path, how, formatRaw(value), formatRaw(to)),
+		})
+		return to
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			return value
+		}
+		return coerceObject(path, obj, t, warnings)
+
+	case reflect.Slice, reflect.Array:
+		_, inner, _ := splitDive(rules)
+		items, ok := value.([]interface{})
+		if !ok {
+			if _, isObj := value.(map[string]interface{}); isObj && t.Elem().Kind() != reflect.Struct {
+				return value
+			}
+			// A single value where a list is expected
+			wrapped := coerceValue(path+"[0]", value, t.Elem(), inner, false, warnings)
+			return changed([]interface{}{wrapped}, "was wrapped in a list")
+		}
+		out := make([]interface{}, len(items))
+		for i, item := range items {
+			out[i] = coerceValue(fmt.Sprintf("%s[%d]", path, i), item, t.Elem(), inner, false, warnings)
+		}
+		return out
+
+	case reflect.Bool:
+		if s, ok := value.(string); ok {
+			if b, err := strconv.ParseBool(strings.TrimSpace(s)); err == nil {
+				return changed(b, "was converted to a boolean")
+			}
+		}
+		return value
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return coerceNumber(value, t.Kind(), rules, clamp, changed)
+
+	case reflect.String:
+		return coerceString(value, rules, changed)
+	}
+	return value
+}
+
+// coerceObject repairs the keys and values of a raw object for struct type t
+func coerceObject(path string, obj map[string]interface{}, t reflect.Type, warnings *[]Warning) map[string]interface{} {
+	fields := jsonFields(t)
+	byName := make(map[string]paramField, len(fields))
+	byCanonical := make(map[string][]paramField)
+	for _, f := range fields {
+		byName[f.JSONName] = f
+		key := canonicalToken(f.JSONName)
+		byCanonical[key] = append(byCanonical[key], f)
+	}
+
+	keys := make([]string, 0, len(obj))
+	for key := range obj {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	out := make(map[string]interface{}, len(obj))
+	for _, key := range keys {
+		value := obj[key]
+		field, exists := byName[key]
+		if !exists {
+			// "aspectRatio" or "Aspect-Ratio" for "aspect_ratio", unless it would clobber a real key
+			if matches := byCanonical[canonicalToken(key)]; len(matches) == 1 {
+				if _, taken := obj[matches[0].JSONName]; !taken {
+					field, exists = matches[0], true
+					*warnings = append(*warnings, Warning{
+						Code:    WarningCoerced,
+						Field:   joinPath(path, field.JSONName),
+						Message: fmt.Sprintf("Parameter '%s' was renamed to '%s'", joinPath(path, key), field.JSONName),
+					})
+				}
+			}
+		}
+		if !exists {
+			out[key] = value // left for strict mode or the decoder to report
+			continue
+		}
+
+		clamp := field.Tag.Get("lenient") == "clamp"
+		out[field.JSONName] = coerceValue(joinPath(path, field.JSONName), value, field.Type,
+			fieldValidateRules(field), clamp, warnings)
+	}
+	return out
+}
+
+// coerceNumber converts numeric strings, and clamps to the field's bounds when allowed
+func coerceNumber(value interface{}, kind reflect.Kind, rules []string, clamp bool,
+	changed func(interface{}, string) interface{}) interface{} {
+
+	var n float64
+	switch v := value.(type) {
+	case float64:
+		n = v
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32:
+		n = reflect.ValueOf(v).Convert(reflect.TypeOf(n)).Float() // Params built in Go rather than decoded from JSON
+	case string:
+		parsed, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil || math.IsNaN(parsed) || math.IsInf(parsed, 0) {
+			return value
+		}
+		n = parsed
+		value = changed(n, "was converted to a number")
+	default:
+		return value
+	}
+
+	isInt := kind != reflect.Float32 && kind != reflect.Float64
+	if isInt && n != math.Trunc(n) {
+		if !clamp {
+			return value // 29.97 for an integer field is a real mistake, not a near-miss
+		}
+		n = math.Round(n)
+		value = changed(n, "was rounded to a whole number")
+	}
+
+	// Zero is how an omitted optional field decodes; clamping it would hide the default
+	if clamp && n != 0 {
+		min, max := numericBounds(rules)
+		if min != nil && n < *min {
+			return changed(*min, "was raised to its minimum")
+		}
+		if max != nil && n > *max {
+			return changed(*max, "was lowered to its maximum")
+		}
+	}
+	return value
+}
+
+// coerceString stringifies numbers and maps near-miss enum values onto their canonical spelling
+func coerceString(value interface{}, rules []string, changed func(interface{}, string) interface{}) interface{} {
+	s, ok := value.(string)
+	if !ok {
+		n, isNumber := value.(float64)
+		if !isNumber {
+			return value
+		}
+		s = strconv.FormatFloat(n, 'f', -1, 64)
+		value = changed(s, "was converted to a string")
+	}
+
+	options := enumOptions(rules)
+	if len(options) == 0 {
+		return value
+	}
+	for _, option := range options {
+		if option == s {
+			return value
+		}
+	}
+
+	var matches []string
+	for _, option := range options {
+		if canonicalToken(option) == canonicalToken(s) {
+			matches = append(matches, option)
+		}
+	}
+	if len(matches) == 1 {
+		return changed(matches[0], "was normalized")
+	}
+	return value
+}
+
+// enumOptions returns the allowed values from oneof or eq rules
+func enumOptions(rules []string) []string {
+	for _, rule := range rules {
+		tag, param, _ := strings.Cut(rule, "=")
+		switch tag {
+		case "oneof":
+			return strings.Fields(param)
+		case "eq":
+			return []string{param}
+		}
+	}
+	return nil
+}
+
+// numericBounds returns the inclusive bounds declared by min/max/gte/lte rules
+func numericBounds(rules []string) (min, max *float64) {
+	outer, _, _ := splitDive(rules)
+	for _, rule := range outer {
+		tag, param, _ := strings.Cut(rule, "=")
+		bound, err := strconv.ParseFloat(param, 64)
+		if err != nil {
+			continue
+		}
+		switch tag {
+		case "min", "gte":
+			min = &bound
+		case "max", "lte":
+			max = &bound
+		}
+	}
+	return min, max
+}
+
+// formatRaw renders a raw param value for a warning message
+func formatRaw(v interface{}) string {
+	switch val := v.(type) {
+	case string:
+		return strconv.Quote(val)
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	case []interface{}:
+		parts := make([]string, len(val))
+		for i, item := range val {
+			parts[i] = formatRaw(item)
+		}
+		return "[" + strings.Join(parts, ", ") + "]"
+	}
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,368 @@
+package tools
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// IssueKind categorizes a registry consistency problem
+type IssueKind string
+
+const (
+	IssueSignableField   IssueKind = "signable_field"
+	IssueMissingMetadata IssueKind = "missing_metadata"
+	IssueSchema          IssueKind = "schema"
+	IssueValidation      IssueKind = "validation"
+	IssueEndpoint        IssueKind = "endpoint"
+	IssueLifecycle       IssueKind = "lifecycle"
+	IssueFamily          IssueKind = "family"
+)
+
+// RegistryIssue describes one place where the registry has drifted from the params structs
+type RegistryIssue struct {
+	Tool    ToolName  `json:"tool"`
+	Kind    IssueKind `json:"kind"`
+	Field   string    `json:"field,omitempty"`
+	Message string    `json:"message"`
+}
+
+// String formats the issue for CLI and test output
+func (i RegistryIssue) String() string {
+	if i.Field != "" {
+		return fmt.Sprintf("%s [%s] %s: %s", i.Tool, i.Kind, i.Field, i.Message)
+	}
+	return fmt.Sprintf("%s [%s] %s", i.Tool, i.Kind, i.Message)
+}
+
+// crossFieldTags are validator tags whose parameters name other struct fields
+var crossFieldTags = map[string]bool{
+	"required_with":        true,
+	"required_with_all":    true,
+	"required_without":     true,
+	"required_without_all": true,
+	"excluded_with":        true,
+	"excluded_with_all":    true,
+	"excluded_without":     true,
+	"excluded_without_all": true,
+	"eqfield":              true,
+	"nefield":              true,
+	"gtfield":              true,
+	"gtefield":             true,
+	"ltfield":              true,
+	"ltefield":             true,
+}
+
+// CheckRegistry reflects over every registered params struct and reports drift between
+// the struct and its metadata, JSON schema, validator tags and signing table.
+// An empty result means the registry is consistent.
+func CheckRegistry() []RegistryIssue {
+	var issues []RegistryIssue
+	for _, name := range RegisteredTools() {
+		def, exists := LookupTool(name)
+		if !exists {
+			continue
+		}
+		issues = append(issues, checkMetadata(def)...)
+		issues = append(issues, checkEndpoint(def)...)
+		issues = append(issues, checkSignable(def)...)
+		issues = append(issues, checkSchema(def)...)
+		issues = append(issues, checkValidation(def)...)
+		issues = append(issues, checkDefaults(def)...)
+		issues = append(issues, checkLifecycle(def)...)
+	}
+
+	for _, family := range ModelFamilies() {
+		issues = append(issues, checkFamily(family)...)
+	}
+
+	// Entries added to the exported map directly never went through Register
+	for name := range Metadata {
+		if _, exists := LookupTool(name); !exists {
+			issues = append(issues, RegistryIssue{
+				Tool:    name,
+				Kind:    IssueMissingMetadata,
+				Message: "present in Metadata but not registered",
+			})
+		}
+	}
+	for name := range SignableFields {
+		if _, exists := LookupTool(name); !exists {
+			issues = append(issues, RegistryIssue{
+				Tool:    name,
+				Kind:    IssueSignableField,
+				Message: "present in SignableFields but not registered",
+			})
+		}
+	}
+
+	return issues
+}
+
+func checkMetadata(def ToolDefinition) []RegistryIssue {
+	var issues []RegistryIssue
+	add := func(field, msg string) {
+		issues = append(issues, RegistryIssue{Tool: def.Name, Kind: IssueMissingMetadata, Field: field, Message: msg})
+	}
+
+	if def.Meta.Description == "" {
+		add("description", "description is empty")
+	}
+	if def.Meta.Type == "" {
+		add("type", "tool type is empty")
+	}
+	if def.Meta.ServiceType == "" {
+		add("service_type", "service type is empty")
+	}
+	if def.Meta.OutputType == "" {
+		add("output_type", "output type is empty")
+	}
+	if def.ResultKind == "" {
+		add("result_kind", "result kind is empty")
+	}
+	return issues
+}
+
+// checkDefaults reports defaults that don't parse, can't be told apart from an
+// omitted value, or fail the field's own validate rules
+func checkDefaults(def ToolDefinition) []RegistryIssue {
+	var issues []RegistryIssue
+	for _, t := range schemaStructTypes(def.ParamsType) {
+		for _, field := range jsonFields(t) {
+			tag, ok := field.Tag.Lookup("default")
+			if !ok {
+				continue
+			}
+			add := func(msg string) {
+				issues = append(issues, RegistryIssue{Tool: def.Name, Kind: IssueValidation, Field: field.JSONName, Message: msg})
+			}
+
+			if field.Type.Kind() == reflect.Bool {
+				add("defaults on non-pointer bools can't be told apart from an explicit false")
+				continue
+			}
+			value, err := parseDefault(field.Type, tag)
+			if err != nil {
+				add(err.Error())
+				continue
+			}
+			outer, _, _ := splitDive(fieldValidateRules(field))
+			if rules := localRules(outer); len(rules) > 0 {
+				if err := validate.Var(reflect.Indirect(value).Interface(), strings.Join(rules, ",")); err != nil {
+					add(fmt.Sprintf("default %q fails the field's validate rules", tag))
+				}
+			}
+		}
+	}
+	return issues
+}
+
+func checkLifecycle(def ToolDefinition) []RegistryIssue {
+	var issues []RegistryIssue
+	add := func(field, msg string) {
+		issues = append(issues, RegistryIssue{Tool: def.Name, Kind: IssueLifecycle, Field: field, Message: msg})
+	}
+
+	if def.Meta.Successor == "" {
+		if def.Migrate != nil {
+			add("successor", "param migration is set but there is no successor")
+		}
+		return issues
+	}
+
+	successor, exists := LookupTool(def.Meta.Successor)
+	if !exists {
+		add("successor", fmt.Sprintf("successor %s is not registered", def.Meta.Successor))
+		return issues
+	}
+	if def.Meta.Successor == def.Name {
+		add("successor", "tool is its own successor")
+	}
+	if def.Migrate != nil {
+		return issues
+	}
+
+	// Without a migration, params must carry over to the successor unchanged
+	for _, field := range jsonFields(def.ParamsType) {
+		if _, exists := jsonFieldByName(successor.ParamsType, field.JSONName); !exists {
+			add(field.JSONName, fmt.Sprintf("field does not exist on successor %s and no param migration is set", successor.Name))
+		}
+	}
+	return issues
+}
+
+// checkFamily reports variants whose params struct no longer matches the
+// constraints derived from their model family
+func checkFamily(family ModelFamily) []RegistryIssue {
+	var issues []RegistryIssue
+	for _, variant := range family.Variants {
+		add := func(field, msg string) {
+			issues = append(issues, RegistryIssue{Tool: variant.Tool, Kind: IssueFamily, Field: field, Message: msg})
+		}
+
+		def, exists := LookupTool(variant.Tool)
+		if !exists {
+			add("", fmt.Sprintf("variant of the %s family is not registered", family.Name))
+			continue
+		}
+		if def.Meta.OutputType != family.Output {
+			add("output_type", fmt.Sprintf("output type %s does not match the %s family (%s)", def.Meta.OutputType, family.Name, family.Output))
+		}
+
+		for _, rule := range family.FieldRules(variant) {
+			field, exists := jsonFieldByName(def.ParamsType, rule.JSONName)
+			switch {
+			case rule.Present && !exists:
+				add(rule.JSONName, fmt.Sprintf("field is required by the %s family capabilities", family.Name))
+			case !rule.Present && exists:
+				add(rule.JSONName, fmt.Sprintf("field is not supported by this %s variant", family.Name))
+			case exists && field.Tag.Get("validate") != rule.Validate:
+				add(rule.JSONName, fmt.Sprintf("validate tag %q, family requires %q", field.Tag.Get("validate"), rule.Validate))
+			}
+		}
+	}
+	return issues
+}
+
+func checkEndpoint(def ToolDefinition) []RegistryIssue {
+	path := def.Meta.EndpointPath
+	if strings.HasPrefix(path, "/api/") && strings.HasSuffix(path, "/async") && len(path) > len("/api//async") {
+		return nil
+	}
+	return []RegistryIssue{{
+		Tool:    def.Name,
+		Kind:    IssueEndpoint,
+		Field:   "endpoint_path",
+		Message: fmt.Sprintf("endpoint %q does not follow the /api/.../async convention", path),
+	}}
+}
+
+func checkSignable(def ToolDefinition) []RegistryIssue {
+	var issues []RegistryIssue
+	for _, name := range def.Signable {
+		field, exists := jsonFieldByName(def.ParamsType, name)
+		if !exists {
+			issues = append(issues, RegistryIssue{
+				Tool:    def.Name,
+				Kind:    IssueSignableField,
+				Field:   name,
+				Message: fmt.Sprintf("signable field does not exist on %s", def.ParamsType.Name()),
+			})
+			continue
+		}
+		if !hasStorageURL(field.Type) {
+			issues = append(issues, RegistryIssue{
+				Tool:    def.Name,
+				Kind:    IssueSignableField,
+				Field:   name,
+				Message: "signable field does not carry a storage_url",
+			})
+		}
+	}
+	return issues
+}
+
+// hasStorageURL reports whether a field is a URL string or holds media with a storage_url
+func hasStorageURL(t reflect.Type) bool {
+	t = elemType(t)
+	if t.Kind() == reflect.String {
+		return true
+	}
+	_, exists := jsonFieldByName(t, "storage_url")
+	return exists
+}
+
+func checkSchema(def ToolDefinition) []RegistryIssue {
+	schema, err := GetJSONSchema(def.Name)
+	if err != nil {
+		return []RegistryIssue{{Tool: def.Name, Kind: IssueSchema, Message: err.Error()}}
+	}
+
+	props := schemaProperties(schema)
+	if props == nil {
+		return []RegistryIssue{{Tool: def.Name, Kind: IssueSchema, Message: "schema has no properties"}}
+	}
+
+	var issues []RegistryIssue
+	for _, field := range jsonFields(def.ParamsType) {
+		if _, exists := props[field.JSONName]; !exists {
+			issues = append(issues, RegistryIssue{
+				Tool:    def.Name,
+				Kind:    IssueSchema,
+				Field:   field.JSONName,
+				Message: "field is missing from the JSON schema",
+			})
+		}
+	}
+	return issues
+}
+
+// schemaProperties returns the top-level properties of a schema, following a root $ref
+func schemaProperties(schema map[string]interface{}) map[string]interface{} {
+	root := schema
+	if ref, ok := schema["$ref"].(string); ok {
+		defs, _ := schema["$defs"].(map[string]interface{})
+		resolved, _ := defs[strings.TrimPrefix(ref, "#/$defs/")].(map[string]interface{})
+		if resolved == nil {
+			return nil
+		}
+		root = resolved
+	}
+	props, _ := root["properties"].(map[string]interface{})
+	return props
+}
+
+func checkValidation(def ToolDefinition) (issues []RegistryIssue) {
+	add := func(field, msg string) {
+		issues = append(issues, RegistryIssue{Tool: def.Name, Kind: IssueValidation, Field: field, Message: msg})
+	}
+
+	if !reflect.PtrTo(def.ParamsType).Implements(reflect.TypeOf((*Validatable)(nil)).Elem()) {
+		add("", fmt.Sprintf("%s has no Validate method; only struct tags are checked", def.ParamsType.Name()))
+	}
+
+	// Cross-field tags must name fields that exist on the same struct
+	goFields := make(map[string]bool)
+	for i := 0; i < def.ParamsType.NumField(); i++ {
+		goFields[def.ParamsType.Field(i).Name] = true
+	}
+	for _, field := range jsonFields(def.ParamsType) {
+		for _, rule := range strings.Split(field.Tag.Get("validate"), ",") {
+			tag, param, found := strings.Cut(rule, "=")
+			if !found || !crossFieldTags[tag] {
+				continue
+			}
+			for _, ref := range strings.Fields(param) {
+				if !goFields[ref] {
+					add(field.JSONName, fmt.Sprintf("%s references unknown field %s", tag, ref))
+				}
+			}
+		}
+	}
+
+	// The validator panics on tags it cannot parse
+	defer func() {
+		if r := recover(); r != nil {
+			add("", fmt.Sprintf("validator tags do not compile: %v", r))
+		}
+	}()
+	_ = validate.Struct(reflect.New(def.ParamsType).Interface())
+
+	return issues
+}
+
+// localRules drops the rules that depend on other fields or on presence
+func localRules(rules []string) []string {
+	var local []string
+	for _, rule := range rules {
+		tag, _, _ := strings.Cut(rule, "=")
+		if tag == "" || tag == "required" || crossFieldTags[tag] {
+			continue
+		}
+		local = append(local, rule)
+	}
+	if len(local) == 1 && local[0] == "omitempty" {
+		return nil
+	}
+	return local
+}
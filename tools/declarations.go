@@ -0,0 +1,445 @@
+package tools
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Dialect identifies an LLM provider's function-calling schema format
+type Dialect string
+
+const (
+	DialectGemini    Dialect = "gemini"    // OpenAPI 3.0 subset; no $ref, additionalProperties or oneOf
+	DialectOpenAI    Dialect = "openai"    // JSON Schema
+	DialectAnthropic Dialect = "anthropic" // JSON Schema
+)
+
+// geminiKeywords are the schema keywords Gemini function declarations accept
+var geminiKeywords = map[string]bool{
+	"type": true, "format": true, "title": true, "description": true, "nullable": true,
+	"enum": true, "default": true, "example": true,
+	"properties": true, "required": true, "minProperties": true, "maxProperties": true, "propertyOrdering": true,
+	"items": true, "minItems": true, "maxItems": true,
+	"minLength": true, "maxLength": true, "pattern": true,
+	"minimum": true, "maximum": true,
+	"anyOf": true,
+}
+
+// jsonSchemaDropKeywords are removed for the JSON Schema dialects; refs are inlined
+// so the declaration is self-contained
+var jsonSchemaDropKeywords = map[string]bool{
+	"$schema": true, "$id": true, "$defs": true, "definitions": true, "$ref": true, "$comment": true,
+}
+
+// GeminiFunctionDeclaration is a Gemini FunctionDeclaration
+type GeminiFunctionDeclaration struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	Parameters  map[string]interface{} `json:"parameters"`
+}
+
+// OpenAITool is an entry of the OpenAI "tools" request field
+type OpenAITool struct {
+	Type     string         `json:"type"` // Always "function"
+	Function OpenAIFunction `json:"function"`
+}
+
+// OpenAIFunction is the function definition inside an OpenAITool
+type OpenAIFunction struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	Parameters  map[string]interface{} `json:"parameters"`
+}
+
+// AnthropicTool is an entry of the Anthropic Messages API "tools" request field
+type AnthropicTool struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	InputSchema map[string]interface{} `json:"input_schema"`
+}
+
+// GetGeminiDeclaration returns the Gemini function declaration for a tool
+func GetGeminiDeclaration(toolName ToolName) (GeminiFunctionDeclaration, error) {
+	meta, params, err := declarationParts(toolName, DialectGemini)
+	if err != nil {
+		return GeminiFunctionDeclaration{}, err
+	}
+	return GeminiFunctionDeclaration{Name: string(toolName), Description: meta.Description, Parameters: params}, nil
+}
+
+// GetOpenAITool returns the OpenAI tool definition for a tool
+func GetOpenAITool(toolName ToolName) (OpenAITool, error) {
+	meta, params, err := declarationParts(toolName, DialectOpenAI)
+	if err != nil {
+		return OpenAITool{}, err
+	}
+	return OpenAITool{
+		Type:     "function",
+		Function: OpenAIFunction{Name: string(toolName), Description: meta.Description, Parameters: params},
+	}, nil
+}
+
+// GetAnthropicTool returns the Anthropic tool definition for a tool
+func GetAnthropicTool(toolName ToolName) (AnthropicTool, error) {
+	meta, params, err := declarationParts(toolName, DialectAnthropic)
+	if err != nil {
+		return AnthropicTool{}, err
+	}
+	return AnthropicTool{Name: string(toolName), Description: meta.Description, InputSchema: params}, nil
+}
+
+// GetGeminiDeclarations returns declarations for every current tool in registration order.
+// Deprecated tools are left out so the model only learns their successors, as are tools
+// failing a filter; pass AvailableTo(caller) to declare only what the caller may use.
+func GetGeminiDeclarations(filters ...ToolFilter) ([]GeminiFunctionDeclaration, error) {
+	var decls []GeminiFunctionDeclaration
+	for _, name := range declarableTools(filters) {
+		decl, err := GetGeminiDeclaration(name)
+		if err != nil {
+			return nil, err
+		}
+		decls = append(decls, decl)
+	}
+	return decls, nil
+}
+
+// GetOpenAITools returns tool definitions for every current tool in registration order
+func GetOpenAITools(filters ...ToolFilter) ([]OpenAITool, error) {
+	var defs []OpenAITool
+	for _, name := range declarableTools(filters) {
+		def, err := GetOpenAITool(name)
+		if err != nil {
+			return nil, err
+		}
+		defs = append(defs, def)
+	}
+	return defs, nil
+}
+
+// GetAnthropicTools returns tool definitions for every current tool in registration order
+func GetAnthropicTools(filters ...ToolFilter) ([]AnthropicTool, error) {
+	var defs []AnthropicTool
+	for _, name := range declarableTools(filters) {
+		def, err := GetAnthropicTool(name)
+		if err != nil {
+			return nil, err
+		}
+		defs = append(defs, def)
+	}
+	return defs, nil
+}
+
+// GetDialectSchema returns a tool's params schema with refs inlined and
+// keywords the dialect doesn't support removed
+func GetDialectSchema(toolName ToolName, dialect Dialect) (map[string]interface{}, error) {
+	_, params, err := declarationParts(toolName, dialect)
+	return params, err
+}
+
+func declarableTools(filters []ToolFilter) []ToolName {
+	var names []ToolName
+	for _, name := range RegisteredTools() {
+		if !IsDeprecated(name) && passes(name, filters) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func declarationParts(toolName ToolName, dialect Dialect) (ToolMeta, map[string]interface{}, error) {
+	meta, exists := GetToolMetadata(toolName)
+	if !exists {
+		return ToolMeta{}, nil, fmt.Errorf("unknown tool: %s", toolName)
+	}
+	schema, err := GetJSONSchema(toolName)
+	if err != nil {
+		return ToolMeta{}, nil, err
+	}
+	inlined, err := InlineSchemaRefs(schema)
+	if err != nil {
+		return ToolMeta{}, nil, fmt.Errorf("tool %s: %w", toolName, err)
+	}
+	params, err := convertSchema(inlined, dialect)
+	if err != nil {
+		return ToolMeta{}, nil, fmt.Errorf("tool %s: %w", toolName, err)
+	}
+	return meta, params, nil
+}
+
+// InlineSchemaRefs returns a copy of schema with every local "#/$defs/..." reference
+// replaced by the definition it points to. Recursive definitions are an error,
+// since they can't be inlined.
+func InlineSchemaRefs(schema map[string]interface{}) (map[string]interface{}, error) {
+	defs, _ := schema["$defs"].(map[string]interface{})
+	inlined, err := inlineNode(schema, defs, nil)
+	if err != nil {
+		return nil, err
+	}
+	out, _ := inlined.(map[string]interface{})
+	delete(out, "$defs")
+	return out, nil
+}
+
+func inlineNode(node interface{}, defs map[string]interface{}, stack []string) (interface{}, error) {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		if ref, ok := n["$ref"].(string); ok {
+			name := strings.TrimPrefix(ref, "#/$defs/")
+			if name == ref {
+				return nil, fmt.Errorf("unsupported schema reference %q", ref)
+			}
+			for _, seen := range stack {
+				if seen == name {
+					return nil, fmt.Errorf("recursive schema definition %q cannot be inlined", name)
+				}
+			}
+			target, exists := defs[name]
+			if !exists {
+				return nil, fmt.Errorf("schema reference %q has no definition", ref)
+			}
+			resolved, err := inlineNode(target, defs, append(stack, name))
+			if err != nil {
+				return nil, err
+			}
+			// Keywords next to $ref (e.g. a description) override the definition's
+			merged := resolved.(map[string]interface{})
+			for key, value := range n {
+				if key == "$ref" {
+					continue
+				}
+				v, err := inlineNode(value, defs, stack)
+				if err != nil {
+					return nil, err
+				}
+				merged[key] = v
+			}
+			return merged, nil
+		}
+
+		out := make(map[string]interface{}, len(n))
+		for key, value := range n {
+			if key == "$defs" {
+				continue
+			}
+			v, err := inlineNode(value, defs, stack)
+			if err != nil {
+				return nil, err
+			}
+			out[key] = v
+		}
+		return out, nil
+
+	case []interface{}:
+		out := make([]interface{}, len(n))
+		for i, value := range n {
+			v, err := inlineNode(value, defs, stack)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = v
+		}
+		return out, nil
+
+	case []string:
+		return append([]string(nil), n...), nil
+	}
+	return node, nil
+}
+
+// convertSchema rewrites an inlined schema node for a dialect
+func convertSchema(node map[string]interface{}, dialect Dialect) (map[string]interface{}, error) {
+	out := make(map[string]interface{}, len(node))
+	for key, value := range node {
+		switch key {
+		case "properties":
+			props, _ := value.(map[string]interface{})
+			converted := make(map[string]interface{}, len(props))
+			for name, prop := range props {
+				propSchema, ok := prop.(map[string]interface{})
+				if !ok {
+					return nil, fmt.Errorf("property %q is not a schema", name)
+				}
+				c, err := convertSchema(propSchema, dialect)
+				if err != nil {
+					return nil, err
+				}
+				converted[name] = c
+			}
+			out[key] = converted
+
+		case "items", "not", "additionalProperties":
+			sub, ok := value.(map[string]interface{})
+			if !ok {
+				out[key] = value // e.g. additionalProperties: false
+				continue
+			}
+			c, err := convertSchema(sub, dialect)
+			if err != nil {
+				return nil, err
+			}
+			out[key] = c
+
+		case "anyOf", "oneOf", "allOf":
+			list, _ := value.([]interface{})
+			converted := make([]interface{}, 0, len(list))
+			for _, item := range list {
+				sub, ok := item.(map[string]interface{})
+				if !ok {
+					return nil, fmt.Errorf("%s entry is not a schema", key)
+				}
+				c, err := convertSchema(sub, dialect)
+				if err != nil {
+					return nil, err
+				}
+				converted = append(converted, c)
+			}
+			out[key] = converted
+
+		default:
+			out[key] = value
+		}
+	}
+
+	if dialect == DialectGemini {
+		return toGeminiSchema(out), nil
+	}
+	for key := range jsonSchemaDropKeywords {
+		delete(out, key)
+	}
+	return out, nil
+}
+
+// toGeminiSchema maps JSON Schema constructs onto the OpenAPI subset Gemini accepts
+// and drops everything else
+func toGeminiSchema(node map[string]interface{}) map[string]interface{} {
+	// ["string", "null"] becomes a nullable string
+	if types, ok := node["type"].([]interface{}); ok {
+		var kept []interface{}
+		for _, t := range types {
+			if t == "null" {
+				node["nullable"] = true
+			} else {
+				kept = append(kept, t)
+			}
+		}
+		if len(kept) == 1 {
+			node["type"] = kept[0]
+		} else {
+			delete(node, "type")
+		}
+	}
+	if value, ok := node["const"]; ok {
+		if _, hasEnum := node["enum"]; !hasEnum {
+			node["enum"] = []interface{}{value}
+		}
+	}
+	// oneOf can't be expressed; anyOf is the closest accepted form
+	if oneOf, ok := node["oneOf"]; ok {
+		if _, hasAnyOf := node["anyOf"]; !hasAnyOf {
+			node["anyOf"] = oneOf
+		}
+	}
+	// anyOf branches that only list required fields (from required_without groups)
+	// aren't valid Gemini schemas; the rule moves into the fields' descriptions
+	if anyOf, ok := node["anyOf"].([]interface{}); ok {
+		var typed []interface{}
+		var groups [][]string
+		for _, branch := range anyOf {
+			b, ok := branch.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if b["type"] != nil {
+				typed = append(typed, b)
+			} else if names := stringList(b["required"]); len(names) > 0 {
+				groups = append(groups, names)
+			}
+		}
+		describeRequiredGroups(node, groups)
+		if len(typed) == 0 {
+			delete(node, "anyOf")
+		} else {
+			node["anyOf"] = typed
+		}
+	}
+	if format, ok := node["format"].(string); ok && format != "date-time" && format != "enum" {
+		delete(node, "format")
+	}
+	// Gemini enums are string-only; other enums are kept as a hint in the description
+	if enum, ok := node["enum"]; ok && (node["type"] != "string" || !allStrings(enum)) {
+		hint := fmt.Sprintf("One of: %s.", strings.Trim(strings.Join(strings.Fields(fmt.Sprint(enum)), ", "), "[]"))
+		if description, _ := node["description"].(string); description != "" {
+			hint = description + " " + hint
+		}
+		node["description"] = hint
+		delete(node, "enum")
+	}
+
+	for key := range node {
+		if !geminiKeywords[key] {
+			delete(node, key)
+		}
+	}
+	return node
+}
+
+// describeRequiredGroups states "at least one of these is required" in the description
+// of every property the groups name, e.g. "One of duration or end_time is required."
+func describeRequiredGroups(node map[string]interface{}, groups [][]string) {
+	if len(groups) < 2 {
+		return
+	}
+	props, _ := node["properties"].(map[string]interface{})
+	alternatives := make([]string, len(groups))
+	for i, group := range groups {
+		alternatives[i] = strings.Join(group, " and ")
+	}
+	last := len(alternatives) - 1
+	hint := fmt.Sprintf("One of %s or %s is required.", strings.Join(alternatives[:last], ", "), alternatives[last])
+
+	for _, group := range groups {
+		for _, name := range group {
+			prop, ok := props[name].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			description := hint
+			if existing, _ := prop["description"].(string); existing != "" {
+				description = existing + " " + hint
+			}
+			prop["description"] = description
+		}
+	}
+}
+
+// stringList returns the strings of a []string or []interface{} value
+func stringList(list interface{}) []string {
+	switch l := list.(type) {
+	case []string:
+		return l
+	case []interface{}:
+		var out []string
+		for _, item := range l {
+			if s, ok := item.(string); ok {
+				out = append(out, s)
+			}
+		}
+		return out
+	}
+	return nil
+}
+
+func allStrings(list interface{}) bool {
+	switch l := list.(type) {
+	case []string:
+		return true
+	case []interface{}:
+		for _, item := range l {
+			if _, ok := item.(string); !ok {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
@@ -0,0 +1,24 @@
+package tools
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGeminiSchemaKeepsRequiredAlternatives(t *testing.T) {
+	schema, err := GetDialectSchema(TrimVideo, DialectGemini)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, exists := schema["anyOf"]; exists {
+		t.Error("Gemini schema kept a required-only anyOf")
+	}
+	props, _ := schema["properties"].(map[string]interface{})
+	for _, field := range []string{"end_time", "duration"} {
+		prop, _ := props[field].(map[string]interface{})
+		description, _ := prop["description"].(string)
+		if !strings.Contains(description, "One of duration or end_time is required.") {
+			t.Errorf("%s description %q does not state the required alternatives", field, description)
+		}
+	}
+}
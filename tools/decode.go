@@ -0,0 +1,259 @@
+package tools
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/metaphi-labs/latent-contracts/errors"
+)
+
+// DecodeOption configures DecodeParams
+type DecodeOption func(*decodeConfig)
+
+type decodeConfig struct {
+	strict      bool
+	mediaChecks bool
+	lenient     bool
+	caller      *CallerContext
+	warnings    *[]Warning
+}
+
+// Strict rejects keys that don't map to a field of the params struct.
+// Without it, unknown or misspelled keys (e.g. "aspectRatio") are silently dropped.
+func Strict() DecodeOption {
+	return func(c *decodeConfig) {
+		c.strict = true
+	}
+}
+
+// CollectWarnings appends non-fatal warnings (alias use, deprecation) to dst
+func CollectWarnings(dst *[]Warning) DecodeOption {
+	return func(c *decodeConfig) {
+		c.warnings = dst
+	}
+}
+
+// DecodeParams decodes raw params into the tool's params struct and validates it.
+// The returned value is a pointer to the params struct (e.g. *TrimVideoParams) and is
+// returned alongside validation errors so callers can still inspect what was decoded.
+// In strict mode unknown fields fail with a VAL_INVALID_PARAMETER ServiceError that
+// lists every offending field. In lenient mode near-miss values are repaired first.
+func DecodeParams(toolName string, params map[string]interface{}, opts ...DecodeOption) (interface{}, error) {
+	cfg := decodeConfig{}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	name, warnings, exists := ResolveToolName(toolName)
+	if !exists {
+		return nil, fmt.Errorf("unknown tool: %s", toolName)
+	}
+	if cfg.warnings != nil {
+		*cfg.warnings = append(*cfg.warnings, warnings...)
+	}
+	def, _ := LookupTool(name)
+	if cfg.caller != nil {
+		if err := availabilityError(def.Meta, *cfg.caller); err != nil {
+			return nil, err
+		}
+	}
+
+	if cfg.lenient {
+		var coerced []Warning
+		params = coerceParams(params, def.ParamsType, &coerced)
+		if cfg.warnings != nil {
+			*cfg.warnings = append(*cfg.warnings, coerced...)
+		}
+	}
+
+	if cfg.strict {
+		if details := unknownFields("", params, def.ParamsType); len(details) > 0 {
+			return nil, unknownFieldsError(toolName, details)
+		}
+	}
+
+	// Convert map to JSON for decoding
+	jsonBytes, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal params: %w", err)
+	}
+
+	p := reflect.New(def.ParamsType).Interface()
+	if err := json.Unmarshal(jsonBytes, p); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if stderrors.As(err, &typeErr) {
+			return nil, ParamErrors{typeErrorDetail(typeErr, params)}
+		}
+		return nil, fmt.Errorf("invalid params for %s: %w", toolName, err)
+	}
+	// Field errors and media constraint violations are reported together
+	constraintDetails := mediaConstraintDetails(def.Meta.InputMedia, p)
+	if err := validateParams(p); err != nil {
+		return p, mergeParamErrors(err, def.ParamsType, constraintDetails)
+	}
+	if len(constraintDetails) > 0 {
+		return p, ParamErrors(constraintDetails)
+	}
+	if cfg.mediaChecks {
+		return p, ValidateMedia(p)
+	}
+	return p, nil
+}
+
+// mergeParamErrors appends constraint details to a validation error, skipping fields
+// the error already reports. Errors that carry no details are returned unchanged.
+func mergeParamErrors(err error, root reflect.Type, constraints []errors.ValidationDetail) error {
+	if len(constraints) == 0 {
+		return err
+	}
+	var merged ParamErrors
+	var fieldErrs validator.ValidationErrors
+	switch {
+	case stderrors.As(err, &merged):
+	case stderrors.As(err, &fieldErrs):
+		merged = fieldErrorDetails(root, fieldErrs)
+	default:
+		return err
+	}
+
+	reported := make(map[string]bool, len(merged))
+	for _, detail := range merged {
+		reported[detail.Field] = true
+	}
+	merged = append(ParamErrors(nil), merged...)
+	for _, detail := range constraints {
+		if !reported[detail.Field] {
+			merged = append(merged, detail)
+		}
+	}
+	return merged
+}
+
+// DecodeParamsAs is the typed form of DecodeParams.
+// T must be the params struct registered for toolName.
+func DecodeParamsAs[T any](toolName string, params map[string]interface{}, opts ...DecodeOption) (*T, error) {
+	name, _, exists := ResolveToolName(toolName)
+	if !exists {
+		return nil, fmt.Errorf("unknown tool: %s", toolName)
+	}
+	def, _ := LookupTool(name)
+	if want := reflect.TypeOf((*T)(nil)).Elem(); def.ParamsType != want {
+		return nil, fmt.Errorf("tool %s takes %s, not %s", toolName, def.ParamsType.Name(), want.Name())
+	}
+
+	p, err := DecodeParams(toolName, params, opts...)
+	if p == nil {
+		return nil, err
+	}
+	return p.(*T), err
+}
+
+// DecodeInto decodes params for the tool registered with params type T
+func DecodeInto[T any](params map[string]interface{}, opts ...DecodeOption) (*T, error) {
+	want := reflect.TypeOf((*T)(nil)).Elem()
+	var matches []ToolName
+	for _, name := range RegisteredTools() {
+		if def, exists := LookupTool(name); exists && def.ParamsType == want {
+			matches = append(matches, name)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no tool is registered with params type %s", want.Name())
+	case 1:
+		return DecodeParamsAs[T](string(matches[0]), params, opts...)
+	default:
+		return nil, fmt.Errorf("params type %s is shared by %d tools; use DecodeParamsAs", want.Name(), len(matches))
+	}
+}
+
+// unknownFields walks raw params against the struct type and reports every key
+// that encoding/json would drop or only match case-insensitively
+func unknownFields(path string, value interface{}, t reflect.Type) []errors.ValidationDetail {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	var details []errors.ValidationDetail
+	switch t.Kind() {
+	case reflect.Struct:
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			return nil // type mismatches are reported by the decoder
+		}
+
+		fields := make(map[string]paramField)
+		for _, f := range jsonFields(t) {
+			fields[f.JSONName] = f
+		}
+
+		keys := make([]string, 0, len(obj))
+		for key := range obj {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			fieldPath := joinPath(path, key)
+			if f, exists := fields[key]; exists {
+				details = append(details, unknownFields(fieldPath, obj[key], f.Type)...)
+				continue
+			}
+
+			detail := errors.ValidationDetail{
+				Field:    fieldPath,
+				Provided: obj[key],
+				Reason:   fmt.Sprintf("Unknown parameter '%s'", key),
+				Code:     errors.VAL_INVALID_PARAMETER,
+			}
+			names := make([]string, 0, len(fields))
+			for name := range fields {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			if name, ok := closestMatch(key, names); ok {
+				detail.Expected = name
+				detail.Suggestion = name
+				detail.Reason = fmt.Sprintf("Unknown parameter '%s', did you mean '%s'?", key, name)
+			}
+			details = append(details, detail)
+		}
+
+	case reflect.Slice, reflect.Array:
+		items, ok := value.([]interface{})
+		if !ok {
+			return nil
+		}
+		for i, item := range items {
+			details = append(details, unknownFields(fmt.Sprintf("%s[%d]", path, i), item, t.Elem())...)
+		}
+	}
+	return details
+}
+
+// joinPath appends a key to a JSON path
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// unknownFieldsError builds the ServiceError returned by strict decoding
+func unknownFieldsError(toolName string, details []errors.ValidationDetail) *errors.ServiceError {
+	message := fmt.Sprintf("Unknown parameter '%s' for tool '%s'", details[0].Field, toolName)
+	if len(details) > 1 {
+		message += fmt.Sprintf(" (+%d more)", len(details)-1)
+	}
+	return errors.NewServiceError(
+		errors.VAL_INVALID_PARAMETER,
+		message,
+		"tool-validation",
+		false,
+	).WithValidationErrors(details)
+}
@@ -0,0 +1,221 @@
+package tools
+
+import (
+	stderrors "errors"
+	"testing"
+
+	"github.com/metaphi-labs/latent-contracts/errors"
+)
+
+// decodeDetails decodes params and returns the validation details of the error
+func decodeDetails(t *testing.T, tool ToolName, params map[string]interface{}, opts ...DecodeOption) ParamErrors {
+	t.Helper()
+	_, err := DecodeParams(string(tool), params, opts...)
+	var details ParamErrors
+	if !stderrors.As(err, &details) {
+		t.Fatalf("DecodeParams error = %v, want ParamErrors", err)
+	}
+	return details
+}
+
+func TestEnumSuggestionIsStructured(t *testing.T) {
+	cases := map[string]string{ // provided -> suggestion
+		"fdae":     "fade",
+		"disolve":  "dissolve",
+		"crossfde": "",
+	}
+	for provided, want := range cases {
+		details := decodeDetails(t, CombineVideos, map[string]interface{}{
+			"videos":     []interface{}{map[string]interface{}{"storage_url": "gs://b/a.mp4"}, map[string]interface{}{"storage_url": "gs://b/b.mp4"}},
+			"transition": provided,
+		})
+		if len(details) != 1 || details[0].Field != "transition" {
+			t.Fatalf("%s: details = %+v, want one transition error", provided, details)
+		}
+		if got := details[0].Suggestion; got != want {
+			t.Errorf("%s: suggestion %q, want %q", provided, got, want)
+		}
+	}
+}
+
+func TestLenientClampKeepsOmittedDefault(t *testing.T) {
+	for name, params := range map[string]map[string]interface{}{
+		"omitted": {"prompt": "a lighthouse at dusk"},
+		"zero":    {"prompt": "a lighthouse at dusk", "duration": 0},
+	} {
+		p, err := DecodeParamsAs[GenerateVideoVeo3Params](string(GenerateVideoVeo3), params, Lenient())
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if err := ApplyDefaults(p); err != nil {
+			t.Fatal(err)
+		}
+		if p.Duration != 8 {
+			t.Errorf("%s: duration = %d, want the default 8", name, p.Duration)
+		}
+	}
+}
+
+func TestTypeErrorReportsProvidedValue(t *testing.T) {
+	params := map[string]interface{}{"prompt": "a lighthouse at dusk", "number_of_images": "two"}
+	_, err := DecodeParams(string(GenerateImageImagen), params)
+
+	serviceErr := ValidationErrorToServiceError(err, string(GenerateImageImagen), "")
+	if serviceErr.Code != errors.VAL_INVALID_FORMAT {
+		t.Errorf("code = %s, want %s", serviceErr.Code, errors.VAL_INVALID_FORMAT)
+	}
+	want := `Parameter 'number_of_images' must be an integer, got "two"`
+	if serviceErr.Message != want {
+		t.Errorf("message = %q, want %q", serviceErr.Message, want)
+	}
+	details := serviceErr.Metadata.ValidationDetails
+	if len(details) != 1 || details[0].Provided != "two" || details[0].Field != "number_of_images" {
+		t.Errorf("details = %+v, want number_of_images provided as \"two\"", details)
+	}
+}
+
+func TestConstraintViolationsReportedWithFieldErrors(t *testing.T) {
+	details := decodeDetails(t, GenerateVideoVeo3, map[string]interface{}{
+		"prompt":   "a lighthouse at dusk",
+		"duration": 30,
+		"image":    map[string]interface{}{"storage_url": "gs://b/frame.gif", "mime_type": "image/gif"},
+	})
+
+	fields := make(map[string]bool)
+	for _, detail := range details {
+		fields[detail.Field] = true
+	}
+	for _, field := range []string{"duration", "image.mime_type"} {
+		if !fields[field] {
+			t.Errorf("%s was not reported; got %+v", field, details)
+		}
+	}
+}
+
+func TestNestedTypeErrorUsesValidatorPath(t *testing.T) {
+	details := decodeDetails(t, CombineVideos, map[string]interface{}{
+		"videos": []interface{}{
+			map[string]interface{}{"storage_url": 42},
+			map[string]interface{}{"storage_url": "gs://b/b.mp4"},
+		},
+	})
+	if len(details) != 1 {
+		t.Fatalf("details = %+v, want one", details)
+	}
+	detail := details[0]
+	if detail.Field != "videos[0].storage_url" {
+		t.Errorf("field = %q, want videos[0].storage_url", detail.Field)
+	}
+	if detail.Provided != 42 {
+		t.Errorf("provided = %#v, want 42", detail.Provided)
+	}
+	if want := "Parameter 'videos[0].storage_url' must be a string, got 42"; detail.Reason != want {
+		t.Errorf("reason = %q, want %q", detail.Reason, want)
+	}
+}
+
+// strictDetails decodes params in strict mode and returns the unknown-field details
+func strictDetails(t *testing.T, tool ToolName, params map[string]interface{}) []errors.ValidationDetail {
+	t.Helper()
+	_, err := DecodeParams(string(tool), params, Strict())
+	var serviceErr *errors.ServiceError
+	if !stderrors.As(err, &serviceErr) {
+		t.Fatalf("DecodeParams error = %v, want a ServiceError", err)
+	}
+	if serviceErr.Code != errors.VAL_INVALID_PARAMETER || !serviceErr.HasValidationErrors() {
+		t.Fatalf("error = %s %q, want %s with details", serviceErr.Code, serviceErr.Message, errors.VAL_INVALID_PARAMETER)
+	}
+	return serviceErr.Metadata.ValidationDetails
+}
+
+func TestStrictRejectsUnknownKey(t *testing.T) {
+	details := strictDetails(t, GenerateImageImagen, map[string]interface{}{
+		"prompt":      "a lighthouse at dusk",
+		"aspectRatio": "16:9",
+	})
+	if len(details) != 1 {
+		t.Fatalf("details = %+v, want one", details)
+	}
+	detail := details[0]
+	if detail.Field != "aspectRatio" || detail.Code != errors.VAL_INVALID_PARAMETER || detail.Suggestion != "aspect_ratio" {
+		t.Errorf("detail = %+v, want aspectRatio with suggestion aspect_ratio", detail)
+	}
+	if detail.Provided != "16:9" {
+		t.Errorf("provided = %#v, want 16:9", detail.Provided)
+	}
+}
+
+func TestStrictRejectsNestedUnknownKey(t *testing.T) {
+	details := strictDetails(t, CombineVideos, map[string]interface{}{
+		"videos": []interface{}{
+			map[string]interface{}{"storage_url": "gs://b/a.mp4"},
+			map[string]interface{}{"storage_url": "gs://b/b.mp4", "x": 1},
+		},
+	})
+	if len(details) != 1 || details[0].Field != "videos[1].x" {
+		t.Errorf("details = %+v, want one for videos[1].x", details)
+	}
+}
+
+func TestStrictReportsEveryUnknownKey(t *testing.T) {
+	details := strictDetails(t, GenerateImageImagen, map[string]interface{}{
+		"prompt":      "a lighthouse at dusk",
+		"aspectRatio": "16:9",
+		"numImages":   2,
+		"colour":      "blue",
+	})
+	fields := make(map[string]bool)
+	for _, detail := range details {
+		fields[detail.Field] = true
+	}
+	if len(details) != 3 || !fields["aspectRatio"] || !fields["numImages"] || !fields["colour"] {
+		t.Errorf("details = %+v, want one per unknown key", details)
+	}
+}
+
+func TestWithoutStrictUnknownKeysAreDropped(t *testing.T) {
+	p, err := DecodeParamsAs[GenerateImageImagenParams](string(GenerateImageImagen), map[string]interface{}{
+		"prompt":      "a lighthouse at dusk",
+		"aspectRatio": "16:9",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.AspectRatio != "" {
+		t.Errorf("aspect ratio = %q, want the unknown key dropped", p.AspectRatio)
+	}
+}
+
+func TestDecodeParamsAsRejectsWrongType(t *testing.T) {
+	_, err := DecodeParamsAs[TrimVideoParams](string(GenerateImageImagen), map[string]interface{}{"prompt": "a lighthouse at dusk"})
+	if err == nil {
+		t.Fatal("decoded imagen params into TrimVideoParams")
+	}
+}
+
+func TestDecodeInto(t *testing.T) {
+	p, err := DecodeInto[TrimVideoParams](map[string]interface{}{
+		"video":    map[string]interface{}{"storage_url": "gs://b/a.mp4"},
+		"end_time": "2",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.EndTime != "2" {
+		t.Errorf("end_time = %q, want 2", p.EndTime)
+	}
+}
+
+func TestDecodeIntoRejectsSharedParamsType(t *testing.T) {
+	const name ToolName = "test-shared-params"
+	def, _ := LookupTool(GoogleSearch)
+	def.Name, def.Meta.Name, def.Aliases = name, name, nil
+	if err := Register(def); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { unregister(name) })
+
+	if _, err := DecodeInto[GoogleSearchParams](map[string]interface{}{"query": "lighthouses"}); err == nil {
+		t.Error("decoded params whose type is shared by two tools")
+	}
+}
@@ -0,0 +1,178 @@
+package tools
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// Defaults are declared on params struct fields with a `default:"..."` tag, e.g.
+//
+//	Duration int `json:"duration,omitempty" validate:"omitempty,min=5,max=8" default:"8"`
+//
+// They are applied to zero-valued fields only, so a default can't distinguish an
+// explicit zero from an omitted field; CheckRegistry rejects defaults on plain bools.
+
+// ApplyDefaults fills every zero-valued field of a params struct that declares a default.
+// params must be a pointer to a struct; nested structs and slices of structs are filled too.
+func ApplyDefaults(params interface{}) error {
+	v := reflect.ValueOf(params)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("ApplyDefaults needs a pointer to a params struct, got %T", params)
+	}
+	return applyDefaults(v.Elem())
+}
+
+func applyDefaults(v reflect.Value) error {
+	for _, field := range jsonFields(v.Type()) {
+		fv := v.FieldByName(field.GoName)
+
+		if tag, ok := field.Tag.Lookup("default"); ok && fv.IsZero() {
+			value, err := parseDefault(field.Type, tag)
+			if err != nil {
+				return fmt.Errorf("%s.%s: %w", v.Type().Name(), field.GoName, err)
+			}
+			fv.Set(value)
+			continue
+		}
+
+		switch fv.Kind() {
+		case reflect.Struct:
+			if err := applyDefaults(fv); err != nil {
+				return err
+			}
+		case reflect.Ptr:
+			if !fv.IsNil() && fv.Elem().Kind() == reflect.Struct {
+				if err := applyDefaults(fv.Elem()); err != nil {
+					return err
+				}
+			}
+		case reflect.Slice:
+			for i := 0; i < fv.Len(); i++ {
+				if item := reflect.Indirect(fv.Index(i)); item.Kind() == reflect.Struct {
+					if err := applyDefaults(item); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Normalize decodes and validates params like DecodeParams, then applies defaults.
+// The result is the fully populated params struct the service will actually run with.
+func Normalize(toolName string, params map[string]interface{}, opts ...DecodeOption) (interface{}, error) {
+	p, err := DecodeParams(toolName, params, opts...)
+	if err != nil {
+		return p, err
+	}
+	if err := ApplyDefaults(p); err != nil {
+		return p, err
+	}
+	return p, nil
+}
+
+// NormalizeAs is the typed form of Normalize
+func NormalizeAs[T any](toolName string, params map[string]interface{}, opts ...DecodeOption) (*T, error) {
+	p, err := DecodeParamsAs[T](toolName, params, opts...)
+	if err != nil {
+		return p, err
+	}
+	if err := ApplyDefaults(p); err != nil {
+		return p, err
+	}
+	return p, nil
+}
+
+// GetDefaults returns the declared defaults of a tool's params, keyed by JSON field name
+func GetDefaults(toolName ToolName) (map[string]interface{}, error) {
+	def, exists := LookupTool(toolName)
+	if !exists {
+		return nil, fmt.Errorf("unknown tool: %s", toolName)
+	}
+	defaults := make(map[string]interface{})
+	for _, field := range jsonFields(def.ParamsType) {
+		tag, ok := field.Tag.Lookup("default")
+		if !ok {
+			continue
+		}
+		value, err := parseDefault(field.Type, tag)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", def.ParamsType.Name(), field.GoName, err)
+		}
+		defaults[field.JSONName] = reflect.Indirect(value).Interface()
+	}
+	return defaults, nil
+}
+
+// parseDefault converts a default tag to a value of the field's type.
+// Pointer fields get a pointer to the parsed value.
+func parseDefault(t reflect.Type, tag string) (reflect.Value, error) {
+	if t.Kind() == reflect.Ptr {
+		elem, err := parseDefault(t.Elem(), tag)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		ptr := reflect.New(t.Elem())
+		ptr.Elem().Set(elem)
+		return ptr, nil
+	}
+
+	v := reflect.New(t).Elem()
+	switch t.Kind() {
+	case reflect.String:
+		v.SetString(tag)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(tag)
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("invalid bool default %q", tag)
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(tag, 10, t.Bits())
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("invalid integer default %q", tag)
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(tag, 10, t.Bits())
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("invalid integer default %q", tag)
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(tag, t.Bits())
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("invalid number default %q", tag)
+		}
+		v.SetFloat(f)
+	default:
+		// Composite defaults are written as JSON, e.g. default:"[\"first\"]"
+		if err := json.Unmarshal([]byte(tag), v.Addr().Interface()); err != nil {
+			return reflect.Value{}, fmt.Errorf("invalid %s default %q: %w", t.Kind(), tag, err)
+		}
+	}
+	return v, nil
+}
+
+// applyDefaultsToSchema publishes declared defaults as the "default" keyword
+// on the matching $defs entries
+func applyDefaultsToSchema(schema map[string]interface{}, root reflect.Type) {
+	defs, _ := schema["$defs"].(map[string]interface{})
+	for _, t := range schemaStructTypes(root) {
+		def, _ := defs[t.Name()].(map[string]interface{})
+		props, _ := def["properties"].(map[string]interface{})
+		for _, field := range jsonFields(t) {
+			tag, ok := field.Tag.Lookup("default")
+			prop, _ := props[field.JSONName].(map[string]interface{})
+			if !ok || prop == nil {
+				continue
+			}
+			if value, err := parseDefault(field.Type, tag); err == nil {
+				prop["default"] = reflect.Indirect(value).Interface()
+			}
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package tools
+
+import (
+	"testing"
+
+	"github.com/metaphi-labs/latent-contracts/results"
+)
+
+func TestNormalizeAppliesDefaults(t *testing.T) {
+	veo, err := NormalizeAs[GenerateVideoVeo3Params](string(GenerateVideoVeo3), map[string]interface{}{
+		"prompt": "waves against a pier at dusk",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if veo.Duration != 8 || veo.FPS != 24 || veo.Resolution != "720p" || veo.SampleCount != 1 {
+		t.Errorf("veo3 defaults = duration %d, fps %d, resolution %q, sample_count %d",
+			veo.Duration, veo.FPS, veo.Resolution, veo.SampleCount)
+	}
+	if veo.AspectRatio != "" {
+		t.Errorf("aspect_ratio declares no default but became %q", veo.AspectRatio)
+	}
+
+	imagen, err := NormalizeAs[GenerateImageImagenParams](string(GenerateImageImagen), map[string]interface{}{
+		"prompt": "a red bicycle",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if imagen.AspectRatio != "1:1" || imagen.NumberOfImages != 1 || imagen.OutputMimeType != "image/png" {
+		t.Errorf("imagen defaults = aspect_ratio %q, number_of_images %d, output_mime_type %q",
+			imagen.AspectRatio, imagen.NumberOfImages, imagen.OutputMimeType)
+	}
+}
+
+func TestNormalizeKeepsExplicitValues(t *testing.T) {
+	p, err := Normalize(string(GenerateVideoVeo3), map[string]interface{}{
+		"prompt":   "waves against a pier at dusk",
+		"duration": 5,
+		"fps":      60,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	veo := p.(*GenerateVideoVeo3Params)
+	if veo.Duration != 5 || veo.FPS != 60 {
+		t.Errorf("explicit values replaced: duration %d, fps %d", veo.Duration, veo.FPS)
+	}
+}
+
+func TestApplyDefaultsRejectsNonPointer(t *testing.T) {
+	if err := ApplyDefaults(GenerateVideoVeo3Params{}); err == nil {
+		t.Error("ApplyDefaults accepted a struct value")
+	}
+}
+
+func TestSchemaPublishesDefaults(t *testing.T) {
+	schema, err := GetJSONSchema(GenerateVideoVeo3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	props := schemaProperties(schema)
+	for field, want := range map[string]interface{}{"duration": 8, "fps": 24, "resolution": "720p"} {
+		prop, _ := props[field].(map[string]interface{})
+		if prop["default"] != want {
+			t.Errorf("%s: schema default %v, want %v", field, prop["default"], want)
+		}
+	}
+	if prop, _ := props["aspect_ratio"].(map[string]interface{}); prop["default"] != nil {
+		t.Errorf("aspect_ratio: schema default %v, want none", prop["default"])
+	}
+}
+
+func TestSetParametersRecordsNormalizedParams(t *testing.T) {
+	p, err := Normalize(string(GenerateImageImagen), map[string]interface{}{"prompt": "a red bicycle"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var result results.MediaGenerationResult
+	if err := result.SetParameters(p); err != nil {
+		t.Fatal(err)
+	}
+	if result.Parameters["aspect_ratio"] != "1:1" || result.Parameters["prompt"] != "a red bicycle" {
+		t.Errorf("recorded parameters = %v", result.Parameters)
+	}
+}
@@ -0,0 +1,418 @@
+package tools
+
+import (
+	"reflect"
+	"time"
+)
+
+// builtinDefinitions declares every tool shipped with the contracts.
+// This is the only place a built-in tool needs to be added; the registry derives
+// metadata, schemas, validation and signing tables from it.
+var builtinDefinitions = []ToolDefinition{
+	// === Media Generation Tools ===
+
+	{
+		Name:       GenerateImageImagen,
+		ParamsType: reflect.TypeOf(GenerateImageImagenParams{}),
+		ResultKind: ResultKindMediaGeneration,
+		Meta: ToolMeta{
+			Name:        GenerateImageImagen,
+			Credits:     2,
+			Type:        ToolTypeMedia,
+			ServiceType: ServiceTypeMediaAI,
+			Description: "Standard quality image generation using Google Imagen 4.0. Balanced quality and speed. Supports creative controls, multiple images, and various aspect ratios. Best for general use cases.",
+			Examples: []string{
+				"Generate a professional product photo",
+				"Create a marketing banner with text",
+				"Design a logo concept",
+				"Illustrate a scene from a story",
+				"Generate multiple variations of an icon",
+			},
+			OutputType:   OutputTypeImage,
+			EndpointPath: "/api/generate-image-imagen/generate/async",
+			Duration:     ExpectedDuration{Typical: 8 * time.Second, P95: 20 * time.Second, Timeout: 2 * time.Minute},
+		},
+	},
+
+	{
+		Name:       GenerateImageImagenFast,
+		ParamsType: reflect.TypeOf(GenerateImageImagenFastParams{}),
+		ResultKind: ResultKindMediaGeneration,
+		Meta: ToolMeta{
+			Name:        GenerateImageImagenFast,
+			Credits:     1,
+			Type:        ToolTypeMedia,
+			ServiceType: ServiceTypeMediaAI,
+			Description: "Fast, cost-effective image generation using Google Imagen 4.0 Fast. Lower quality but quicker generation and reduced cost. Best for drafts, iterations, and testing.",
+			Examples: []string{
+				"Quick draft of a product mockup",
+				"Generate test images for layout",
+				"Fast iteration on concept art",
+				"Budget-friendly batch image generation",
+			},
+			OutputType:   OutputTypeImage,
+			EndpointPath: "/api/generate-image-imagen-fast/generate/async",
+			Duration:     ExpectedDuration{Typical: 4 * time.Second, P95: 10 * time.Second, Timeout: time.Minute},
+		},
+	},
+
+	{
+		Name:       GenerateImageImagenUltra,
+		ParamsType: reflect.TypeOf(GenerateImageImagenUltraParams{}),
+		ResultKind: ResultKindMediaGeneration,
+		Meta: ToolMeta{
+			Name:        GenerateImageImagenUltra,
+			Credits:     3,
+			Type:        ToolTypeMedia,
+			ServiceType: ServiceTypeMediaAI,
+			Description: "Premium ultra-high quality image generation using Google Imagen 4.0 Ultra. Highest quality, photorealistic results. Limited to 1 image per request. Best for hero images, professional photography, and final production.",
+			Examples: []string{
+				"Ultra-realistic product photography for marketing",
+				"Photorealistic portrait for professional use",
+				"High-end architectural visualization",
+				"Premium quality hero image for website",
+				"Museum-quality artistic rendering",
+			},
+			OutputType:   OutputTypeImage,
+			EndpointPath: "/api/generate-image-imagen-ultra/generate/async",
+			Duration:     ExpectedDuration{Typical: 15 * time.Second, P95: 35 * time.Second, Timeout: 3 * time.Minute},
+			Availability: Availability{MinTier: TierPro},
+		},
+	},
+
+	{
+		Name:       NanoBanana,
+		ParamsType: reflect.TypeOf(NanoBananaParams{}),
+		ResultKind: ResultKindMediaGeneration,
+		Meta: ToolMeta{
+			Name:        NanoBanana,
+			Credits:     3,
+			Type:        ToolTypeMedia,
+			ServiceType: ServiceTypeMediaAI,
+			Description: "Versatile image generation with Gemini 2.5 Flash Image Preview. Supports text-to-image, image editing, style transfer, and multi-image composition. Best for creative editing and conversational image generation.",
+			Examples: []string{
+				"Create a photorealistic portrait of an elderly Japanese ceramicist",
+				"Edit this image to add a sunset background",
+				"Combine these three images into a creative composition",
+				"Apply the style of this painting to my photo",
+				"Transform this sketch into a detailed illustration",
+			},
+			OutputType:   OutputTypeImage,
+			EndpointPath: "/api/nano-banana/generate/async",
+			Duration:     ExpectedDuration{Typical: 10 * time.Second, P95: 25 * time.Second, Timeout: 2 * time.Minute},
+			InputMedia:   MediaConstraints{Image: geminiImageInput},
+		},
+	},
+
+	{
+		Name:       GenerateVideoVeo3,
+		ParamsType: reflect.TypeOf(GenerateVideoVeo3Params{}),
+		ResultKind: ResultKindMediaGeneration,
+		Meta: ToolMeta{
+			Name:        GenerateVideoVeo3,
+			Credits:     300,
+			Type:        ToolTypeMedia,
+			ServiceType: ServiceTypeMediaAI,
+			Description: "Premium video generation using Google Veo3. Creates high-quality 8-second videos at up to 1080p resolution with optional audio generation. Supports both text-to-video and image-to-video with optional ending frame control. Best for final production quality.",
+			Examples: []string{
+				"Create a cinematic 8-second video in 1080p",
+				"Generate high-quality product showcase video",
+				"Transform this image into a dynamic 8-second video",
+				"Make a professional marketing video with specific camera movements",
+				"Create artistic video from image with zoom effect",
+			},
+			OutputType:   OutputTypeVideo,
+			EndpointPath: "/api/generate-video-veo3/generate/async",
+			Duration:     ExpectedDuration{Typical: 90 * time.Second, P95: 2 * time.Minute, Timeout: 5 * time.Minute},
+			InputMedia:   MediaConstraints{Image: vertexImageInput},
+			Availability: Availability{MinTier: TierPro},
+			RateLimit:    veo3RateLimit,
+		},
+	},
+
+	{
+		Name:       GenerateVideoVeo3Fast,
+		ParamsType: reflect.TypeOf(GenerateVideoVeo3FastParams{}),
+		ResultKind: ResultKindMediaGeneration,
+		Meta: ToolMeta{
+			Name:        GenerateVideoVeo3Fast,
+			Credits:     160,
+			Type:        ToolTypeMedia,
+			ServiceType: ServiceTypeMediaAI,
+			Description: "Fast, cost-effective video generation using Google Veo3 Fast. Generates 8-second videos at 720p resolution with optional audio generation. Best for quick iterations and testing.",
+			Examples: []string{
+				"Create an 8-second video of a sunset",
+				"Generate a preview of product demo",
+				"Make a social media clip of nature scene",
+				"Quick test video of abstract patterns",
+			},
+			OutputType:   OutputTypeVideo,
+			EndpointPath: "/api/generate-video-veo3-fast/generate/async",
+			Duration:     ExpectedDuration{Typical: 45 * time.Second, P95: 90 * time.Second, Timeout: 4 * time.Minute},
+			Availability: Availability{MinTier: TierPro},
+			RateLimit:    veo3RateLimit,
+		},
+	},
+
+	{
+		Name:       GenerateVideoVeo3FastNoAudio,
+		ParamsType: reflect.TypeOf(GenerateVideoVeo3FastNoAudioParams{}),
+		ResultKind: ResultKindMediaGeneration,
+		Migrate:    withoutAudio,
+		Meta: ToolMeta{
+			Name:        GenerateVideoVeo3FastNoAudio,
+			Credits:     100,
+			Type:        ToolTypeMedia,
+			ServiceType: ServiceTypeMediaAI,
+			Description: "Fast, cost-effective video generation using Google Veo3 Fast. Generates 8-second videos at 720p resolution without audio generation.",
+			Examples: []string{
+				"Create an 8-second video of a sunset",
+				"Generate a preview of product demo",
+				"Make a social media clip of nature scene",
+				"Quick test video of abstract patterns",
+			},
+			OutputType:   OutputTypeVideo,
+			EndpointPath: "/api/generate-video-veo3-fast-no-audio/generate/async",
+			Duration:     ExpectedDuration{Typical: 45 * time.Second, P95: 90 * time.Second, Timeout: 4 * time.Minute},
+			Availability: Availability{MinTier: TierPro},
+			RateLimit:    veo3RateLimit,
+			DeprecatedAt: deprecatedOn(2026, time.October, 16),
+			Successor:    GenerateVideoVeo3Fast,
+		},
+	},
+
+	{
+		Name:       GenerateVideoVeo3NoAudio,
+		ParamsType: reflect.TypeOf(GenerateVideoVeo3NoAudioParams{}),
+		ResultKind: ResultKindMediaGeneration,
+		Meta: ToolMeta{
+			Name:        GenerateVideoVeo3NoAudio,
+			Credits:     200,
+			Type:        ToolTypeMedia,
+			ServiceType: ServiceTypeMediaAI,
+			Description: "Premium video generation using Google Veo3. Creates high-quality 8-second videos at up to 1080p resolution without audio generation. Supports both text-to-video and image-to-video with optional ending frame control. Best for final production quality.",
+			Examples: []string{
+				"Create a cinematic 8-second video in 1080p",
+				"Generate high-quality product showcase video",
+				"Transform this image into a dynamic 8-second video",
+				"Make a professional marketing video with specific camera movements",
+				"Create artistic video from image with zoom effect",
+			},
+			OutputType:   OutputTypeVideo,
+			EndpointPath: "/api/generate-video-veo3-no-audio/generate/async",
+			Duration:     ExpectedDuration{Typical: 90 * time.Second, P95: 2 * time.Minute, Timeout: 5 * time.Minute},
+			InputMedia:   MediaConstraints{Image: vertexImageInput},
+			Availability: Availability{MinTier: TierPro},
+			RateLimit:    veo3RateLimit,
+		},
+	},
+
+	{
+		Name:       GenerateMusicLyria,
+		ParamsType: reflect.TypeOf(GenerateMusicLyriaParams{}),
+		ResultKind: ResultKindMediaGeneration,
+		Meta: ToolMeta{
+			Name:        GenerateMusicLyria,
+			Credits:     3,
+			Type:        ToolTypeMedia,
+			ServiceType: ServiceTypeMediaAI,
+			Description: "Generate high-quality music using Google Lyria. Creates 30-second instrumental tracks in various genres and styles. Supports custom seeds for reproducible generation.",
+			Examples: []string{
+				"Smooth jazz with mellow brass and piano",
+				"Epic orchestral battle music with heavy drums",
+				"Lo-fi hip hop beat for studying",
+			},
+			OutputType:   OutputTypeAudio,
+			EndpointPath: "/api/generate-music-lyria/generate/async",
+			Duration:     ExpectedDuration{Typical: 20 * time.Second, P95: 45 * time.Second, Timeout: 3 * time.Minute},
+		},
+	},
+
+	// === Video Processing Tools ===
+
+	{
+		Name:       CombineVideos,
+		ParamsType: reflect.TypeOf(CombineVideosParams{}),
+		Signable:   []string{"videos"},
+		ResultKind: ResultKindVideoProcessing,
+		Meta: ToolMeta{
+			Name:        CombineVideos,
+			Credits:     20,
+			Type:        ToolTypeMedia,
+			ServiceType: ServiceTypeVideoProcessor,
+			Description: "Combine multiple videos into a single video with optional transitions. Supports fade and dissolve transitions between clips.",
+			Examples: []string{
+				"Combine two videos with fade transition",
+				"Merge multiple clips into one video",
+				"Create a compilation video with transitions",
+			},
+			OutputType:   OutputTypeVideo,
+			EndpointPath: "/api/video/combine/async",
+			Duration:     ExpectedDuration{Typical: 15 * time.Second, P95: time.Minute, Timeout: 5 * time.Minute},
+			InputMedia:   MediaConstraints{Video: processorVideoInput},
+		},
+	},
+
+	{
+		Name:       TrimVideo,
+		ParamsType: reflect.TypeOf(TrimVideoParams{}),
+		Signable:   []string{"video"},
+		ResultKind: ResultKindVideoProcessing,
+		Meta: ToolMeta{
+			Name:        TrimVideo,
+			Credits:     10,
+			Type:        ToolTypeMedia,
+			ServiceType: ServiceTypeVideoProcessor,
+			Description: "Trim a video by specifying start and end times or duration. Supports fast mode for quick processing.",
+			Examples: []string{
+				"Extract 30-second clip starting at 10 seconds",
+				"Trim video from 0:10 to 0:40",
+				"Cut the first 5 seconds from a video",
+			},
+			OutputType:   OutputTypeVideo,
+			EndpointPath: "/api/video/trim/async",
+			Duration:     ExpectedDuration{Typical: 5 * time.Second, P95: 20 * time.Second, Timeout: 2 * time.Minute},
+			InputMedia:   MediaConstraints{Video: processorVideoInput},
+		},
+	},
+
+	{
+		Name:       ImageAudioMerge,
+		ParamsType: reflect.TypeOf(ImageAudioMergeParams{}),
+		Signable:   []string{"image", "audio"},
+		ResultKind: ResultKindVideoProcessing,
+		Meta: ToolMeta{
+			Name:        ImageAudioMerge,
+			Credits:     15,
+			Type:        ToolTypeMedia,
+			ServiceType: ServiceTypeVideoProcessor,
+			Description: "Create a video by combining a static image with an audio track. Perfect for music visualizations or podcast videos.",
+			Examples: []string{
+				"Create music video with album cover",
+				"Make a podcast video with logo",
+				"Generate audio visualization with background image",
+			},
+			OutputType:   OutputTypeVideo,
+			EndpointPath: "/api/video/image-audio-merge/async",
+			Duration:     ExpectedDuration{Typical: 10 * time.Second, P95: 30 * time.Second, Timeout: 3 * time.Minute},
+			InputMedia:   MediaConstraints{Image: processorImageInput, Audio: processorAudioInput},
+		},
+	},
+
+	{
+		Name:       ExtractFrame,
+		ParamsType: reflect.TypeOf(ExtractFrameParams{}),
+		Signable:   []string{"video"},
+		ResultKind: ResultKindVideoProcessing,
+		Meta: ToolMeta{
+			Name:        ExtractFrame,
+			Credits:     5,
+			Type:        ToolTypeMedia,
+			ServiceType: ServiceTypeVideoProcessor,
+			Description: "Extract frames from videos at specific positions or timestamps. Supports first, last, middle positions or exact timestamps. Works with generated videos, uploads, or gallery assets.",
+			Examples: []string{
+				"Extract the last frame from this video",
+				"Get frame at 5 seconds from the video",
+				"Extract the middle frame from the generated video",
+				"Get the first frame as a thumbnail",
+				"Extract frame at 00:00:03.5 from my upload",
+			},
+			OutputType:   OutputTypeImage,
+			EndpointPath: "/api/video/extract-frame/async",
+			Duration:     ExpectedDuration{Typical: 2 * time.Second, P95: 5 * time.Second, Timeout: 30 * time.Second},
+			InputMedia:   MediaConstraints{Video: processorVideoInput},
+		},
+	},
+
+	{
+		Name:       MergeImages,
+		ParamsType: reflect.TypeOf(MergeImagesParams{}),
+		Signable:   []string{"images"},
+		ResultKind: ResultKindVideoProcessing,
+		Meta: ToolMeta{
+			Name:         MergeImages,
+			Credits:      5,
+			Type:         ToolTypeImageProcessing,
+			ServiceType:  ServiceTypeMediaAI,
+			Description:  "Merge multiple images into a single composite",
+			Examples:     []string{},
+			OutputType:   OutputTypeImage,
+			EndpointPath: "/api/merge-images/generate/async",
+			Duration:     ExpectedDuration{Typical: 3 * time.Second, P95: 8 * time.Second, Timeout: time.Minute},
+			InputMedia:   MediaConstraints{Image: processorImageInput},
+		},
+	},
+
+	{
+		Name:       ImagesToVideo,
+		ParamsType: reflect.TypeOf(ImagesToVideoParams{}),
+		Signable:   []string{"images", "audio"},
+		ResultKind: ResultKindVideoProcessing,
+		Meta: ToolMeta{
+			Name:        ImagesToVideo,
+			Credits:     10,
+			Type:        ToolTypeMedia,
+			ServiceType: ServiceTypeVideoProcessor,
+			Description: "Create a video from multiple images with transitions and optional audio",
+			Examples: []string{
+				"create a slideshow from these images",
+				"make a video from these photos with fade transitions",
+				"turn these images into a video with background music",
+			},
+			OutputType:   OutputTypeVideo,
+			EndpointPath: "/api/video/images-to-video/async",
+			Duration:     ExpectedDuration{Typical: 20 * time.Second, P95: 90 * time.Second, Timeout: 5 * time.Minute},
+			InputMedia:   MediaConstraints{Image: processorImageInput, Audio: processorAudioInput},
+		},
+	},
+
+	// === Content Analysis Tools ===
+
+	{
+		Name:       ContentAnalyzer,
+		ParamsType: reflect.TypeOf(ContentAnalyzerParams{}),
+		ResultKind: ResultKindNative,
+		Meta: ToolMeta{
+			Name:        ContentAnalyzer,
+			Credits:     1,
+			Type:        ToolTypeNative,
+			ServiceType: ServiceTypeMediaAI,
+			Description: "Analyzes a given web page(s) for information as needed for the core user task or request",
+			Examples: []string{
+				"analyze this YouTube video",
+				"summarize this article",
+				"extract key points from this webpage",
+			},
+			OutputType:   OutputTypeText,
+			EndpointPath: "/api/content-analyzer/generate/async",
+			Duration:     ExpectedDuration{Typical: 10 * time.Second, P95: 30 * time.Second, Timeout: 2 * time.Minute},
+		},
+	},
+
+	{
+		Name:       GoogleSearch,
+		ParamsType: reflect.TypeOf(GoogleSearchParams{}),
+		ResultKind: ResultKindNative,
+		Meta: ToolMeta{
+			Name:        GoogleSearch,
+			Credits:     0,
+			Type:        ToolTypeNative,
+			ServiceType: ServiceTypeMediaAI,
+			Description: "Proactively or upon instruction search the internet to build context on the user's request or domain",
+			Examples: []string{
+				"search for AI developments",
+				"find information about renewable energy",
+				"research competitors",
+			},
+			OutputType:   OutputTypeJSON,
+			EndpointPath: "/api/google-search/generate/async",
+			Duration:     ExpectedDuration{Typical: 2 * time.Second, P95: 5 * time.Second, Timeout: 30 * time.Second},
+		},
+	},
+}
+
+// deprecatedOn returns a deprecation date at midnight UTC
+func deprecatedOn(year int, month time.Month, day int) *time.Time {
+	t := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	return &t
+}
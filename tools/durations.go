@@ -0,0 +1,52 @@
+package tools
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/metaphi-labs/latent-contracts/progress"
+)
+
+// DefaultTimeout applies to tools whose metadata declares no timeout
+const DefaultTimeout = 10 * time.Minute
+
+// ExpectedDuration describes how long a tool's jobs run, measured from start to result
+type ExpectedDuration struct {
+	Typical time.Duration // Median job; the ETA before any progress is reported
+	P95     time.Duration // 95th percentile
+	Timeout time.Duration // Jobs still running after this are failed with TOOL_TIMEOUT
+}
+
+// TimeoutOrDefault returns the timeout, or DefaultTimeout when none is declared
+func (d ExpectedDuration) TimeoutOrDefault() time.Duration {
+	if d.Timeout > 0 {
+		return d.Timeout
+	}
+	return DefaultTimeout
+}
+
+// validateDuration checks the expected duration of a tool being registered
+func validateDuration(d ExpectedDuration) error {
+	if d.Typical < 0 || d.P95 < 0 || d.Timeout < 0 {
+		return fmt.Errorf("durations must not be negative")
+	}
+	if d.Typical > 0 && d.P95 > 0 && d.P95 < d.Typical {
+		return fmt.Errorf("p95 duration %s is shorter than typical %s", d.P95, d.Typical)
+	}
+	if d.Timeout > 0 && d.Timeout < d.P95 {
+		return fmt.Errorf("timeout %s would fail jobs faster than the p95 duration %s", d.Timeout, d.P95)
+	}
+	return nil
+}
+
+// EstimateETA estimates when a job will finish, using the tool's typical duration
+// as a prior that gives way to the reported progress as it grows. Returns nil for
+// finished jobs and when neither progress nor a typical duration is known.
+func EstimateETA(u *progress.Update) *time.Time {
+	var typical time.Duration
+	if name, _, exists := ResolveToolName(u.Tool); exists {
+		meta, _ := GetToolMetadata(name)
+		typical = meta.Duration.Typical
+	}
+	return u.CalculateETAWithPrior(typical)
+}
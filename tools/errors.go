@@ -1,141 +1,65 @@
 package tools
 
 import (
+	"encoding/json"
+	stderrors "errors"
 	"fmt"
+	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/metaphi-labs/latent-contracts/errors"
 )
 
-// ValidationErrorToServiceError converts validation errors to user-friendly ServiceErrors
+// ValidationErrorToServiceError converts validation errors to user-friendly ServiceErrors.
+// Every failing field is reported in Metadata.ValidationDetails; the top-level code and
+// message describe the first one.
 func ValidationErrorToServiceError(err error, toolName string, paramName string) *errors.ServiceError {
 	if err == nil {
 		return nil
 	}
 
-	// Handle validator.ValidationErrors
-	if validationErrors, ok := err.(validator.ValidationErrors); ok {
-		for _, fieldErr := range validationErrors {
-			field := fieldErr.Field()
-			tag := fieldErr.Tag()
-			param := fieldErr.Param()
-
-			// Map validator tags to error codes and messages
-			switch tag {
-			case "required":
-				return errors.NewServiceError(
-					errors.VAL_MISSING_PARAMETER,
-					fmt.Sprintf("Required parameter '%s' is missing for tool '%s'",
-						toSnakeCase(field), toolName),
-					"tool-validation",
-					false,
-				)
-
-			case "min":
-				if fieldErr.Kind().String() == "string" {
-					return errors.NewServiceError(
-						errors.VAL_STRING_TOO_SHORT,
-						fmt.Sprintf("Parameter '%s' must be at least %s characters long",
-							toSnakeCase(field), param),
-						"tool-validation",
-						false,
-					)
-				}
-				return errors.NewServiceError(
-					errors.VAL_OUT_OF_RANGE,
-					fmt.Sprintf("Parameter '%s' must be at least %s",
-						toSnakeCase(field), param),
-					"tool-validation",
-					false,
-				)
-
-			case "max":
-				if fieldErr.Kind().String() == "string" {
-					return errors.NewServiceError(
-						errors.VAL_STRING_TOO_LONG,
-						fmt.Sprintf("Parameter '%s' must be at most %s characters long",
-							toSnakeCase(field), param),
-						"tool-validation",
-						false,
-					)
-				}
-				if fieldErr.Kind().String() == "slice" {
-					return errors.NewServiceError(
-						errors.VAL_ARRAY_TOO_LONG,
-						fmt.Sprintf("Parameter '%s' must have at most %s items",
-							toSnakeCase(field), param),
-						"tool-validation",
-						false,
-					)
-				}
-				return errors.NewServiceError(
-					errors.VAL_OUT_OF_RANGE,
-					fmt.Sprintf("Parameter '%s' must be at most %s",
-						toSnakeCase(field), param),
-					"tool-validation",
-					false,
-				)
-
-			case "oneof":
-				return errors.NewServiceError(
-					errors.VAL_INVALID_ENUM,
-					fmt.Sprintf("Parameter '%s' must be one of: %s",
-						toSnakeCase(field), param),
-					"tool-validation",
-					false,
-				)
-
-			case "eq":
-				return errors.NewServiceError(
-					errors.VAL_INVALID_PARAMETER,
-					fmt.Sprintf("Parameter '%s' must be exactly %s",
-						toSnakeCase(field), param),
-					"tool-validation",
-					false,
-				)
-
-			case "url":
-				return errors.NewServiceError(
-					errors.VAL_INVALID_URL,
-					fmt.Sprintf("Parameter '%s' must be a valid URL",
-						toSnakeCase(field)),
-					"tool-validation",
-					false,
-				)
-
-			case "required_without":
-				return errors.NewServiceError(
-					errors.VAL_DEPENDENCY_MISSING,
-					fmt.Sprintf("Parameter '%s' is required when '%s' is not provided",
-						toSnakeCase(field), toSnakeCase(param)),
-					"tool-validation",
-					false,
-				)
-
-			default:
-				return errors.NewServiceError(
-					errors.VAL_INVALID_PARAMETER,
-					fmt.Sprintf("Parameter '%s' is invalid: %s validation failed",
-						toSnakeCase(field), tag),
-					"tool-validation",
-					false,
-				)
-			}
+	var details []errors.ValidationDetail
+	switch e := err.(type) {
+	case *errors.ServiceError:
+		// Already structured (e.g. strict decoding)
+		return e
+	case ParamErrors:
+		details = e
+	case validator.ValidationErrors:
+		var root reflect.Type
+		if def, exists := LookupTool(ToolName(toolName)); exists {
+			root = def.ParamsType
 		}
+		details = fieldErrorDetails(root, e)
 	}
 
-	// Handle custom validation errors
-	if strings.Contains(err.Error(), "either") && strings.Contains(err.Error(), "required") {
+	if len(details) > 0 {
+		message := details[0].Reason
+		if len(details) > 1 {
+			message += fmt.Sprintf(" (+%d more)", len(details)-1)
+		}
 		return errors.NewServiceError(
-			errors.VAL_MUTUALLY_EXCLUSIVE,
-			err.Error(),
+			details[0].Code,
+			message,
 			"tool-validation",
 			false,
-		)
+		).WithValidationErrors(details)
 	}
 
-	// Handle JSON unmarshal errors
+	// Handle JSON unmarshal errors; DecodeParams reports these as ParamErrors with
+	// the provided value, so only errors from other decoders land here
+	var typeErr *json.UnmarshalTypeError
+	if stderrors.As(err, &typeErr) {
+		detail := typeErrorDetail(typeErr, nil)
+		return errors.NewServiceError(
+			errors.VAL_INVALID_FORMAT,
+			detail.Reason,
+			"tool-validation",
+			false,
+		).WithValidationErrors([]errors.ValidationDetail{detail})
+	}
 	if strings.Contains(err.Error(), "cannot unmarshal") {
 		return errors.NewServiceError(
 			errors.VAL_INVALID_FORMAT,
@@ -154,6 +78,80 @@ func ValidationErrorToServiceError(err error, toolName string, paramName string)
 	)
 }
 
+// typeErrorDetail describes a value of the wrong JSON type. The error's path is
+// reported in the validator's form and the provided value is looked up in params;
+// without params, only the value's JSON type is known.
+func typeErrorDetail(typeErr *json.UnmarshalTypeError, params map[string]interface{}) errors.ValidationDetail {
+	expected := jsonTypeName(typeErr.Type)
+	field, provided, found := valueAtPath(params, typeErr.Field)
+	detail := errors.ValidationDetail{
+		Field:    field,
+		Expected: expected,
+		Reason:   fmt.Sprintf("Parameter '%s' must be %s, got JSON %s", field, expected, typeErr.Value),
+		Code:     errors.VAL_INVALID_FORMAT,
+	}
+	if found {
+		detail.Provided = provided
+		detail.Reason = fmt.Sprintf("Parameter '%s' must be %s, got %s", field, expected, formatRaw(provided))
+	}
+	return detail
+}
+
+// valueAtPath follows an encoding/json field path such as "videos.0.storage_url"
+// through params. It returns the path as "videos[0].storage_url" and the value
+// found there, if any. Numeric segments are list indices unless they name a key
+// of an object in params.
+func valueAtPath(params map[string]interface{}, path string) (string, interface{}, bool) {
+	var field string
+	var current interface{} = params
+	found := params != nil
+	for _, segment := range strings.Split(path, ".") {
+		index, err := strconv.Atoi(segment)
+		list, isList := current.([]interface{})
+		if err == nil && (isList || !found) {
+			field += fmt.Sprintf("[%d]", index)
+		} else {
+			field = joinPath(field, segment)
+		}
+
+		switch {
+		case !found:
+		case isList:
+			found = err == nil && index >= 0 && index < len(list)
+			if found {
+				current = list[index]
+			}
+		default:
+			obj, ok := current.(map[string]interface{})
+			if ok {
+				current, ok = obj[segment]
+			}
+			found = ok
+		}
+	}
+	return field, current, found
+}
+
+// jsonTypeName names the JSON type a Go type decodes from, with its article
+func jsonTypeName(t reflect.Type) string {
+	switch derefType(t).Kind() {
+	case reflect.Bool:
+		return "a boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "an integer"
+	case reflect.Float32, reflect.Float64:
+		return "a number"
+	case reflect.String:
+		return "a string"
+	case reflect.Slice, reflect.Array:
+		return "a list"
+	case reflect.Map, reflect.Struct:
+		return "an object"
+	}
+	return t.String()
+}
+
 // toSnakeCase converts PascalCase to snake_case
 func toSnakeCase(s string) string {
 	var result []rune
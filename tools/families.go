@@ -0,0 +1,245 @@
+package tools
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ModelFamily groups the tool variants that wrap the same model.
+// Variants share a params layout and differ only in the capabilities listed
+// on ModelVariant. The variants' params structs stay hand-written: the family
+// does not generate their tags or schema. Instead FieldRules derives what each
+// capability-bound field must look like, and CheckRegistry (tags) and
+// TestModelFamilyConstraints (tags and schema) fail when a struct drifts from it.
+type ModelFamily struct {
+	Name     string
+	Output   OutputType
+	Variants []ModelVariant
+}
+
+// ModelVariant describes what one tool in a family supports
+type ModelVariant struct {
+	Tool        ToolName
+	Audio       bool     // Can generate audio; toggled with generate_audio
+	ImageInput  bool     // Accepts a start (and end) frame for image-to-video
+	MaxOutputs  int      // Most images or samples per call
+	Resolutions []string // Video output resolutions; a single entry is fixed and not a param
+	ImageSizes  []string // Image output sizes
+}
+
+// Model families for the built-in generation tools
+var (
+	Veo3Family = ModelFamily{
+		Name:   "veo3",
+		Output: OutputTypeVideo,
+		Variants: []ModelVariant{
+			{Tool: GenerateVideoVeo3, Audio: true, ImageInput: true, MaxOutputs: 4, Resolutions: []string{"720p", "1080p"}},
+			{Tool: GenerateVideoVeo3Fast, Audio: true, MaxOutputs: 4, Resolutions: []string{"720p"}},
+			{Tool: GenerateVideoVeo3FastNoAudio, MaxOutputs: 4, Resolutions: []string{"720p"}},
+			{Tool: GenerateVideoVeo3NoAudio, ImageInput: true, MaxOutputs: 4, Resolutions: []string{"720p", "1080p"}},
+		},
+	}
+
+	ImagenFamily = ModelFamily{
+		Name:   "imagen",
+		Output: OutputTypeImage,
+		Variants: []ModelVariant{
+			{Tool: GenerateImageImagen, MaxOutputs: 4, ImageSizes: []string{"1K", "2K"}},
+			{Tool: GenerateImageImagenFast, MaxOutputs: 4, ImageSizes: []string{"1K"}},
+			{Tool: GenerateImageImagenUltra, MaxOutputs: 1, ImageSizes: []string{"1K", "2K"}},
+		},
+	}
+)
+
+// ModelFamilies returns the built-in model families
+func ModelFamilies() []ModelFamily {
+	return []ModelFamily{Veo3Family, ImagenFamily}
+}
+
+// FamilyOf returns the family and variant a tool belongs to
+func FamilyOf(name ToolName) (ModelFamily, ModelVariant, bool) {
+	for _, family := range ModelFamilies() {
+		for _, variant := range family.Variants {
+			if variant.Tool == name {
+				return family, variant, true
+			}
+		}
+	}
+	return ModelFamily{}, ModelVariant{}, false
+}
+
+// FieldRule is the constraint a family places on one params field
+type FieldRule struct {
+	JSONName string
+	Present  bool   // Whether the variant's params struct has the field
+	Validate string // Expected validate tag when present
+}
+
+// FieldRules derives the capability-bound params fields of a variant from its family
+func (f ModelFamily) FieldRules(v ModelVariant) []FieldRule {
+	switch f.Output {
+	case OutputTypeVideo:
+		return []FieldRule{
+			{JSONName: "sample_count", Present: true, Validate: countRule(v.MaxOutputs)},
+			{JSONName: "resolution", Present: len(v.Resolutions) > 1, Validate: choiceRule(v.Resolutions)},
+			{JSONName: "generate_audio", Present: v.Audio},
+			{JSONName: "image", Present: v.ImageInput, Validate: "required_without=Prompt"},
+			{JSONName: "last_frame", Present: v.ImageInput},
+		}
+	case OutputTypeImage:
+		return []FieldRule{
+			{JSONName: "number_of_images", Present: true, Validate: countRule(v.MaxOutputs)},
+			{JSONName: "image_size", Present: true, Validate: choiceRule(v.ImageSizes)},
+		}
+	}
+	return nil
+}
+
+// countRule is the validate tag for an optional output count
+func countRule(max int) string {
+	if max <= 1 {
+		return "omitempty,eq=1"
+	}
+	return fmt.Sprintf("omitempty,min=1,max=%d", max)
+}
+
+// choiceRule is the validate tag for an optional choice between values
+func choiceRule(values []string) string {
+	if len(values) == 1 {
+		return "omitempty,eq=" + values[0]
+	}
+	return "omitempty,oneof=" + strings.Join(values, " ")
+}
+
+// Intent describes a generation request without naming a variant
+type Intent struct {
+	Output          OutputType // OutputTypeImage or OutputTypeVideo
+	Resolution      string     // Video resolution, e.g. "1080p"; empty for any
+	ImageSize       string     // Image size, e.g. "2K"; empty for any
+	Audio           bool       // Video with generated audio
+	FromImage       bool       // Image-to-video
+	Outputs         int        // Images or samples wanted; 0 means 1
+	AllowDeprecated bool       // Consider deprecated variants
+}
+
+// Route is a variant that can serve an intent and the params that express the intent
+type Route struct {
+	Tool   ToolName
+	Family string
+	Params map[string]interface{} // Merge the prompt and inputs into these
+}
+
+// IntentRoutes returns every variant that can satisfy the intent, in family order.
+// When none can, the error names each variant and why it was rejected.
+// pricing.RouteIntent picks the cheapest of them.
+func IntentRoutes(intent Intent) ([]Route, error) {
+	var routes []Route
+	var rejections []string
+	for _, family := range ModelFamilies() {
+		if family.Output != intent.Output {
+			continue
+		}
+		for _, variant := range family.Variants {
+			meta, exists := GetToolMetadata(variant.Tool)
+			if !exists {
+				continue
+			}
+			if reason := variant.unsupported(intent, meta); reason != "" {
+				rejections = append(rejections, fmt.Sprintf("%s %s", variant.Tool, reason))
+				continue
+			}
+			routes = append(routes, Route{
+				Tool:   variant.Tool,
+				Family: family.Name,
+				Params: variant.intentParams(intent),
+			})
+		}
+	}
+
+	if len(routes) == 0 {
+		if len(rejections) == 0 {
+			return nil, fmt.Errorf("no model family produces %s output", intent.Output)
+		}
+		return nil, fmt.Errorf("no %s model supports %s: %s",
+			intent.Output, intent.describe(), strings.Join(rejections, "; "))
+	}
+	return routes, nil
+}
+
+// unsupported returns why the variant can't serve the intent, or "" if it can
+func (v ModelVariant) unsupported(intent Intent, meta ToolMeta) string {
+	switch {
+	case meta.DeprecatedAt != nil && !intent.AllowDeprecated:
+		return "is deprecated"
+	case intent.Audio && !v.Audio:
+		return "cannot generate audio"
+	case intent.FromImage && !v.ImageInput:
+		return "does not accept an input image"
+	case intent.Outputs > v.MaxOutputs:
+		return fmt.Sprintf("generates at most %d per call", v.MaxOutputs)
+	case intent.Resolution != "" && !containsString(v.Resolutions, intent.Resolution):
+		return fmt.Sprintf("supports resolutions %s", strings.Join(v.Resolutions, ", "))
+	case intent.ImageSize != "" && !containsString(v.ImageSizes, intent.ImageSize):
+		return fmt.Sprintf("supports image sizes %s", strings.Join(v.ImageSizes, ", "))
+	}
+	return ""
+}
+
+// intentParams expresses the intent as params for the variant
+func (v ModelVariant) intentParams(intent Intent) map[string]interface{} {
+	params := make(map[string]interface{})
+	if intent.Outputs > 1 {
+		if len(v.ImageSizes) > 0 {
+			params["number_of_images"] = intent.Outputs
+		} else {
+			params["sample_count"] = intent.Outputs
+		}
+	}
+	if intent.Resolution != "" && len(v.Resolutions) > 1 {
+		params["resolution"] = intent.Resolution
+	}
+	if intent.ImageSize != "" {
+		params["image_size"] = intent.ImageSize
+	}
+	if v.Audio {
+		params["generate_audio"] = intent.Audio
+	}
+	return params
+}
+
+// describe summarizes the intent for error messages
+func (intent Intent) describe() string {
+	var parts []string
+	if intent.Resolution != "" {
+		parts = append(parts, intent.Resolution)
+	}
+	if intent.ImageSize != "" {
+		parts = append(parts, intent.ImageSize)
+	}
+	if intent.Output == OutputTypeVideo {
+		if intent.Audio {
+			parts = append(parts, "with audio")
+		} else {
+			parts = append(parts, "without audio")
+		}
+	}
+	if intent.FromImage {
+		parts = append(parts, "from an image")
+	}
+	if intent.Outputs > 1 {
+		parts = append(parts, fmt.Sprintf("%d outputs", intent.Outputs))
+	}
+	if len(parts) == 0 {
+		return "the request"
+	}
+	return strings.Join(parts, ", ")
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,88 @@
+package tools
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// TestModelFamilyConstraints checks every variant's params struct and JSON schema
+// against the constraints its family derives from the variant's capabilities
+func TestModelFamilyConstraints(t *testing.T) {
+	for _, family := range ModelFamilies() {
+		for _, variant := range family.Variants {
+			family, variant := family, variant
+			t.Run(string(variant.Tool), func(t *testing.T) {
+				for _, issue := range checkFamily(ModelFamily{Name: family.Name, Output: family.Output, Variants: []ModelVariant{variant}}) {
+					t.Error(issue)
+				}
+
+				schema, err := GetJSONSchema(variant.Tool)
+				if err != nil {
+					t.Fatal(err)
+				}
+				props := schemaProperties(schema)
+				for _, rule := range family.FieldRules(variant) {
+					prop, exists := props[rule.JSONName].(map[string]interface{})
+					if exists != rule.Present {
+						t.Errorf("%s: in schema = %v, family requires %v", rule.JSONName, exists, rule.Present)
+						continue
+					}
+					if exists {
+						schemaMatchesRule(t, rule, prop, variant)
+					}
+				}
+			})
+		}
+	}
+}
+
+// schemaMatchesRule checks the schema keywords generated for a capability-bound field
+func schemaMatchesRule(t *testing.T, rule FieldRule, prop map[string]interface{}, variant ModelVariant) {
+	t.Helper()
+	switch rule.JSONName {
+	case "sample_count", "number_of_images":
+		if variant.MaxOutputs <= 1 {
+			expectEnum(t, rule.JSONName, prop, []string{"1"})
+		} else if max := fmt.Sprint(prop["maximum"]); max != fmt.Sprint(variant.MaxOutputs) {
+			t.Errorf("%s: schema maximum %s, family allows %d", rule.JSONName, max, variant.MaxOutputs)
+		}
+	case "resolution":
+		expectEnum(t, rule.JSONName, prop, variant.Resolutions)
+	case "image_size":
+		expectEnum(t, rule.JSONName, prop, variant.ImageSizes)
+	}
+}
+
+func expectEnum(t *testing.T, field string, prop map[string]interface{}, want []string) {
+	t.Helper()
+	enum, _ := prop["enum"].([]interface{})
+	got := make([]string, len(enum))
+	for i, value := range enum {
+		got[i] = fmt.Sprint(value)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("%s: schema enum %v, family allows %v", field, got, want)
+	}
+}
+
+// TestCheckFamilyReportsDrift changes a capability and expects the hand-written tags to be flagged
+func TestCheckFamilyReportsDrift(t *testing.T) {
+	drifted := ModelFamily{
+		Name:   ImagenFamily.Name,
+		Output: ImagenFamily.Output,
+		Variants: []ModelVariant{
+			{Tool: GenerateImageImagen, MaxOutputs: 2, ImageSizes: []string{"1K"}},
+		},
+	}
+
+	flagged := make(map[string]bool)
+	for _, issue := range checkFamily(drifted) {
+		flagged[issue.Field] = true
+	}
+	for _, field := range []string{"number_of_images", "image_size"} {
+		if !flagged[field] {
+			t.Errorf("%s drift was not reported", field)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package tools
+
+import (
+	"reflect"
+	"strings"
+)
+
+// paramField describes a params struct field as it appears on the wire
+type paramField struct {
+	JSONName  string
+	GoName    string
+	OmitEmpty bool
+	Type      reflect.Type
+	Tag       reflect.StructTag
+}
+
+// jsonFields returns the JSON-visible fields of a struct type in declaration order.
+// Embedded structs are flattened the same way encoding/json does it.
+func jsonFields(t reflect.Type) []paramField {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var fields []paramField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" && !f.Anonymous {
+			continue // unexported
+		}
+
+		name, opts := parseJSONTag(f.Tag.Get("json"))
+		if name == "-" && opts == "" {
+			continue
+		}
+		if f.Anonymous && name == "" {
+			fields = append(fields, jsonFields(f.Type)...)
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+
+		fields = append(fields, paramField{
+			JSONName:  name,
+			GoName:    f.Name,
+			OmitEmpty: strings.Contains(","+opts+",", ",omitempty,"),
+			Type:      f.Type,
+			Tag:       f.Tag,
+		})
+	}
+	return fields
+}
+
+// jsonFieldByName finds a field by its JSON name
+func jsonFieldByName(t reflect.Type, jsonName string) (paramField, bool) {
+	for _, f := range jsonFields(t) {
+		if f.JSONName == jsonName {
+			return f, true
+		}
+	}
+	return paramField{}, false
+}
+
+// parseJSONTag splits a json struct tag into its name and options
+func parseJSONTag(tag string) (string, string) {
+	if idx := strings.Index(tag, ","); idx != -1 {
+		return tag[:idx], tag[idx+1:]
+	}
+	return tag, ""
+}
+
+// elemType unwraps pointers, slices and arrays down to the element type
+func elemType(t reflect.Type) reflect.Type {
+	for {
+		switch t.Kind() {
+		case reflect.Ptr, reflect.Slice, reflect.Array:
+			t = t.Elem()
+		default:
+			return t
+		}
+	}
+}
@@ -0,0 +1,185 @@
+package tools
+
+import (
+	"fmt"
+	"time"
+)
+
+// ParamMigration upgrades params for a deprecated tool into params for its successor
+type ParamMigration func(params map[string]interface{}) (map[string]interface{}, error)
+
+// WarningCode identifies the kind of non-fatal notice in a Warning
+type WarningCode string
+
+const (
+	WarningAliasResolved WarningCode = "alias_resolved"
+	WarningDeprecated    WarningCode = "deprecated"
+	WarningMigrated      WarningCode = "migrated"
+)
+
+// Warning is a non-fatal notice produced while resolving or decoding a tool call.
+// Chat AI can surface these to the model so it stops using stale names or values.
+type Warning struct {
+	Code    WarningCode `json:"code"`
+	Tool    ToolName    `json:"tool,omitempty"`
+	Field   string      `json:"field,omitempty"`
+	Message string      `json:"message"`
+}
+
+// String formats the warning for logs
+func (w Warning) String() string {
+	return w.Message
+}
+
+// maxMigrationSteps guards against successor cycles
+const maxMigrationSteps = 8
+
+// RegisterAlias maps an additional name onto a registered tool, e.g. a name used
+// by old conversations before a model variant was renamed
+func RegisterAlias(alias, target ToolName) error {
+	toolRegistry.mu.Lock()
+	defer toolRegistry.mu.Unlock()
+	return toolRegistry.addAlias(alias, target)
+}
+
+// addAlias records an alias; the caller must hold the registry lock
+func (r *registry) addAlias(alias, target ToolName) error {
+	if err := r.checkAlias(alias, target); err != nil {
+		return err
+	}
+	if _, exists := r.defs[target]; !exists {
+		return fmt.Errorf("alias %s targets unknown tool %s", alias, target)
+	}
+	r.aliases[alias] = target
+	return nil
+}
+
+// checkAlias reports whether alias can point to target without changing anything;
+// the caller must hold the registry lock
+func (r *registry) checkAlias(alias, target ToolName) error {
+	if alias == "" {
+		return fmt.Errorf("alias is required")
+	}
+	if alias == target {
+		return fmt.Errorf("alias %s is the tool's own name", alias)
+	}
+	if _, exists := r.defs[alias]; exists {
+		return fmt.Errorf("alias %s collides with a registered tool", alias)
+	}
+	if existing, exists := r.aliases[alias]; exists && existing != target {
+		return fmt.Errorf("alias %s already points to %s", alias, existing)
+	}
+	return nil
+}
+
+// Aliases returns a copy of the alias table
+func Aliases() map[ToolName]ToolName {
+	toolRegistry.mu.RLock()
+	defer toolRegistry.mu.RUnlock()
+	aliases := make(map[ToolName]ToolName, len(toolRegistry.aliases))
+	for alias, target := range toolRegistry.aliases {
+		aliases[alias] = target
+	}
+	return aliases
+}
+
+// ResolveToolName maps a tool name or alias to a registered tool.
+// Warnings report alias use and deprecation of the resolved tool.
+func ResolveToolName(name string) (ToolName, []Warning, bool) {
+	toolRegistry.mu.RLock()
+	resolved := ToolName(name)
+	var warnings []Warning
+	if target, isAlias := toolRegistry.aliases[resolved]; isAlias {
+		warnings = append(warnings, Warning{
+			Code:    WarningAliasResolved,
+			Tool:    target,
+			Message: fmt.Sprintf("Tool '%s' is an alias; use '%s'", name, target),
+		})
+		resolved = target
+	}
+	def, exists := toolRegistry.defs[resolved]
+	toolRegistry.mu.RUnlock()
+
+	if !exists {
+		return "", nil, false
+	}
+	if w, deprecated := deprecationWarning(def.Meta, time.Now()); deprecated {
+		warnings = append(warnings, w)
+	}
+	return resolved, warnings, true
+}
+
+// IsDeprecated returns true if the tool has a deprecation date that has passed
+func IsDeprecated(name ToolName) bool {
+	meta, exists := GetToolMetadata(name)
+	return exists && meta.DeprecatedAt != nil && !meta.DeprecatedAt.After(time.Now())
+}
+
+// deprecationWarning describes the tool's deprecation, if it has a deprecation date
+func deprecationWarning(meta ToolMeta, now time.Time) (Warning, bool) {
+	if meta.DeprecatedAt == nil {
+		return Warning{}, false
+	}
+
+	date := meta.DeprecatedAt.Format("2006-01-02")
+	message := fmt.Sprintf("Tool '%s' is deprecated since %s", meta.Name, date)
+	if meta.DeprecatedAt.After(now) {
+		message = fmt.Sprintf("Tool '%s' will be deprecated on %s", meta.Name, date)
+	}
+	if meta.Successor != "" {
+		message += fmt.Sprintf("; use '%s' instead", meta.Successor)
+	}
+	return Warning{Code: WarningDeprecated, Tool: meta.Name, Message: message}, true
+}
+
+// MigrateCall upgrades a call to a deprecated tool into a call to its successor,
+// following successor chains. Calls to current tools are returned unchanged.
+func MigrateCall(name string, params map[string]interface{}) (ToolName, map[string]interface{}, []Warning, error) {
+	current, warnings, exists := ResolveToolName(name)
+	if !exists {
+		return "", nil, nil, fmt.Errorf("unknown tool: %s", name)
+	}
+
+	for step := 0; ; step++ {
+		def, _ := LookupTool(current)
+		if def.Meta.DeprecatedAt == nil || def.Meta.Successor == "" {
+			return current, params, warnings, nil
+		}
+		if step == maxMigrationSteps {
+			return "", nil, warnings, fmt.Errorf("tool %s: successor chain is too long or cyclic", name)
+		}
+		if _, exists := LookupTool(def.Meta.Successor); !exists {
+			return "", nil, warnings, fmt.Errorf("tool %s: successor %s is not registered", current, def.Meta.Successor)
+		}
+
+		if def.Migrate != nil {
+			migrated, err := def.Migrate(copyParams(params))
+			if err != nil {
+				return "", nil, warnings, fmt.Errorf("migrating %s to %s: %w", current, def.Meta.Successor, err)
+			}
+			params = migrated
+		}
+		warnings = append(warnings, Warning{
+			Code:    WarningMigrated,
+			Tool:    def.Meta.Successor,
+			Message: fmt.Sprintf("Call to '%s' was upgraded to '%s'", current, def.Meta.Successor),
+		})
+		current = def.Meta.Successor
+	}
+}
+
+// copyParams makes a shallow copy so migrations can't modify the caller's map
+func copyParams(params map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(params)+1)
+	for key, value := range params {
+		out[key] = value
+	}
+	return out
+}
+
+// withoutAudio is the migration for the no-audio Veo variants, whose successors
+// take the same params plus an explicit generate_audio flag
+func withoutAudio(params map[string]interface{}) (map[string]interface{}, error) {
+	params["generate_audio"] = false
+	return params, nil
+}
@@ -0,0 +1,220 @@
+package tools
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/metaphi-labs/latent-contracts/errors"
+	"github.com/metaphi-labs/latent-contracts/types"
+)
+
+// Limits enforced against the declared properties of input media
+const (
+	MaxCombinedVideoSeconds = 600.0 // Longest output combine-videos will produce
+	MaxImagesToVideoSeconds = 600.0 // Longest slideshow images-to-video will produce
+	aspectRatioTolerance    = 0.05  // Relative slack when matching image aspect ratios
+)
+
+// MediaValidatable is implemented by params structs whose values can be cross-checked
+// against the declared properties (duration, dimensions) of their input media.
+// Checks are skipped for properties the caller didn't provide.
+type MediaValidatable interface {
+	ValidateMedia() error
+}
+
+// ValidateMedia runs the media-aware checks for a decoded params struct.
+// Returns nil when the type has no media checks or all of them pass.
+func ValidateMedia(params interface{}) error {
+	if v, ok := params.(MediaValidatable); ok {
+		return v.ValidateMedia()
+	}
+	return nil
+}
+
+// WithMediaChecks makes DecodeParams also run ValidateMedia once the params are valid
+func WithMediaChecks() DecodeOption {
+	return func(c *decodeConfig) {
+		c.mediaChecks = true
+	}
+}
+
+// ValidateMedia checks the trim window fits inside the input video
+func (p TrimVideoParams) ValidateMedia() error {
+	if p.Video.Duration == nil {
+		return nil
+	}
+	start, end, err := p.Range(0)
+	if err != nil {
+		return nil // reported by Validate
+	}
+
+	length := *p.Video.Duration
+	var details []errors.ValidationDetail
+	if start >= length {
+		details = append(details, beyondVideoDetail("start_time", p.StartTime, start, length))
+	} else if end > length+durationTolerance {
+		field, provided := "end_time", interface{}(p.EndTime)
+		if p.EndTime == "" {
+			field, provided = "duration", *p.Duration
+		}
+		details = append(details, beyondVideoDetail(field, provided, end, length))
+	}
+	return paramErrorsOrNil(details)
+}
+
+// ValidateMedia checks requested timestamps exist in the input video; the last
+// frame starts before the end, so a timestamp at the duration has none
+func (p ExtractFrameParams) ValidateMedia() error {
+	if p.Video.Duration == nil {
+		return nil
+	}
+	length := *p.Video.Duration
+
+	var details []errors.ValidationDetail
+	check := func(field, value string) {
+		tc, err := types.ParseTimecode(value)
+		if err != nil {
+			return // reported by Validate
+		}
+		if at := tc.Seconds(0); at >= length {
+			details = append(details, beyondVideoDetail(field, value, at, length))
+		}
+	}
+
+	if p.Timestamp != "" {
+		check("timestamp", p.Timestamp)
+	}
+	for i, position := range p.Positions {
+		if !framePositions[position] {
+			check(fmt.Sprintf("positions[%d]", i), position)
+		}
+	}
+	return paramErrorsOrNil(details)
+}
+
+// ValidateMedia checks the combined output stays under MaxCombinedVideoSeconds.
+// Clips without a declared duration count as zero, so the check is a lower bound.
+func (p CombineVideosParams) ValidateMedia() error {
+	total := 0.0
+	for _, video := range p.Videos {
+		if video.Duration != nil {
+			total += *video.Duration
+		}
+	}
+	if p.Transition == "fade" || p.Transition == "dissolve" {
+		total -= p.FadeDuration * float64(len(p.Videos)-1) // transitions overlap adjacent clips
+	}
+
+	if total <= MaxCombinedVideoSeconds {
+		return nil
+	}
+	return ParamErrors{{
+		Field:    "videos",
+		Provided: total,
+		Expected: map[string]interface{}{"maximum": MaxCombinedVideoSeconds},
+		Reason:   fmt.Sprintf("Combined video length %.1fs exceeds the %.0fs limit", total, MaxCombinedVideoSeconds),
+		Code:     errors.MEDIA_INVALID_DURATION,
+	}}
+}
+
+// ValidateMedia checks the slideshow stays under MaxImagesToVideoSeconds.
+// Skipped when no per-image duration is given.
+func (p ImagesToVideoParams) ValidateMedia() error {
+	if p.Duration <= 0 {
+		return nil
+	}
+	total := p.Duration * float64(len(p.Images))
+	if total <= MaxImagesToVideoSeconds {
+		return nil
+	}
+	return ParamErrors{{
+		Field:    "duration",
+		Provided: p.Duration,
+		Expected: map[string]interface{}{"maximum": MaxImagesToVideoSeconds / float64(len(p.Images))},
+		Reason: fmt.Sprintf("%d images at %gs each make a %.1fs video, over the %.0fs limit",
+			len(p.Images), p.Duration, total, MaxImagesToVideoSeconds),
+		Code: errors.MEDIA_INVALID_DURATION,
+	}}
+}
+
+// ValidateMedia checks image-to-video frames match a supported aspect ratio
+func (p GenerateVideoVeo3Params) ValidateMedia() error {
+	return veoFrameErrors(p.AspectRatio, p.Image, p.LastFrame)
+}
+
+// ValidateMedia checks image-to-video frames match a supported aspect ratio
+func (p GenerateVideoVeo3NoAudioParams) ValidateMedia() error {
+	return veoFrameErrors(p.AspectRatio, p.Image, p.LastFrame)
+}
+
+// veoFrameErrors checks the start and end frames against the requested aspect ratio,
+// or against any ratio Veo supports when none was requested
+func veoFrameErrors(aspectRatio string, image, lastFrame *types.InputImage) error {
+	allowed := []string{"16:9", "9:16"}
+	if aspectRatio != "" {
+		allowed = []string{aspectRatio}
+	}
+
+	var details []errors.ValidationDetail
+	for _, frame := range []struct {
+		field string
+		image *types.InputImage
+	}{{"image", image}, {"last_frame", lastFrame}} {
+		if frame.image == nil || frame.image.Width == nil || frame.image.Height == nil || *frame.image.Height == 0 {
+			continue
+		}
+		ratio := float64(*frame.image.Width) / float64(*frame.image.Height)
+		if matchesAnyAspectRatio(ratio, allowed) {
+			continue
+		}
+		details = append(details, errors.ValidationDetail{
+			Field:    frame.field,
+			Provided: fmt.Sprintf("%dx%d", *frame.image.Width, *frame.image.Height),
+			Expected: allowed,
+			Reason: fmt.Sprintf("Image '%s' is %dx%d; expected aspect ratio %s",
+				frame.field, *frame.image.Width, *frame.image.Height, strings.Join(allowed, " or ")),
+			Code: errors.MEDIA_INVALID_ASPECT_RATIO,
+		})
+	}
+	return paramErrorsOrNil(details)
+}
+
+// matchesAnyAspectRatio reports whether ratio is within tolerance of any "W:H" ratio
+func matchesAnyAspectRatio(ratio float64, ratios []string) bool {
+	for _, r := range ratios {
+		w, h, found := strings.Cut(r, ":")
+		if !found {
+			continue
+		}
+		width, errW := strconv.ParseFloat(w, 64)
+		height, errH := strconv.ParseFloat(h, 64)
+		if errW != nil || errH != nil || height == 0 {
+			continue
+		}
+		if target := width / height; math.Abs(ratio-target)/target <= aspectRatioTolerance {
+			return true
+		}
+	}
+	return false
+}
+
+// beyondVideoDetail reports a time that falls outside the input video
+func beyondVideoDetail(field string, provided interface{}, at, length float64) errors.ValidationDetail {
+	return errors.ValidationDetail{
+		Field:    field,
+		Provided: provided,
+		Expected: map[string]interface{}{"maximum": length},
+		Reason:   fmt.Sprintf("Parameter '%s' (%gs) is beyond the end of the %gs input video", field, at, length),
+		Code:     errors.MEDIA_INVALID_DURATION,
+	}
+}
+
+// paramErrorsOrNil avoids returning a typed nil error
+func paramErrorsOrNil(details []errors.ValidationDetail) error {
+	if len(details) == 0 {
+		return nil
+	}
+	return ParamErrors(details)
+}
@@ -0,0 +1,25 @@
+package tools
+
+import (
+	"testing"
+
+	"github.com/metaphi-labs/latent-contracts/types"
+)
+
+func TestExtractFrameRejectsTimestampAtEnd(t *testing.T) {
+	length := 10.0
+	cases := map[string]bool{ // timestamp -> valid
+		"9.5": true,
+		"10":  false,
+		"12":  false,
+	}
+	for timestamp, valid := range cases {
+		p := ExtractFrameParams{
+			Video:     types.InputVideo{Duration: &length},
+			Timestamp: timestamp,
+		}
+		if err := p.ValidateMedia(); (err == nil) != valid {
+			t.Errorf("timestamp %s on a %gs video: err = %v, want valid %v", timestamp, length, err, valid)
+		}
+	}
+}
@@ -0,0 +1,307 @@
+package tools
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/metaphi-labs/latent-contracts/errors"
+	"github.com/metaphi-labs/latent-contracts/types"
+)
+
+// MediaConstraints limits the input media a tool accepts, per media kind.
+// A nil kind means the tool places no limits on that kind.
+type MediaConstraints struct {
+	Image *MediaLimits `json:"image,omitempty"`
+	Video *MediaLimits `json:"video,omitempty"`
+	Audio *MediaLimits `json:"audio,omitempty"`
+}
+
+// MediaLimits are the limits for one kind of input media.
+// Zero values mean "no limit". MIME types match case-insensitively and ignore
+// parameters such as "; codecs=...". There is deliberately no pixel-count limit:
+// the providers bound width and height separately, and a product of two fields
+// can't be published as a JSON Schema keyword for the model to see.
+type MediaLimits struct {
+	MimeTypes   []string `json:"mime_types,omitempty"`
+	MaxBytes    int64    `json:"max_bytes,omitempty"`
+	MinWidth    int      `json:"min_width,omitempty"`
+	MinHeight   int      `json:"min_height,omitempty"`
+	MaxWidth    int      `json:"max_width,omitempty"`
+	MaxHeight   int      `json:"max_height,omitempty"`
+	MaxDuration float64  `json:"max_duration_seconds,omitempty"` // For video and audio
+}
+
+const megabyte = 1024 * 1024
+
+// Standard limits shared by the built-in tools
+var (
+	vertexImageInput = &MediaLimits{
+		MimeTypes: []string{"image/png", "image/jpeg"},
+		MaxBytes:  20 * megabyte,
+	}
+
+	geminiImageInput = &MediaLimits{
+		MimeTypes: []string{"image/png", "image/jpeg", "image/webp", "image/heic", "image/heif"},
+		MaxBytes:  7 * megabyte,
+	}
+
+	processorImageInput = &MediaLimits{
+		MimeTypes: []string{"image/png", "image/jpeg", "image/webp"},
+		MaxBytes:  20 * megabyte,
+		MinWidth:  64,
+		MinHeight: 64,
+		MaxWidth:  4096,
+		MaxHeight: 4096,
+	}
+
+	processorVideoInput = &MediaLimits{
+		MimeTypes: []string{"video/mp4", "video/webm", "video/quicktime"},
+		MaxBytes:  500 * megabyte,
+		MaxWidth:  3840,
+		MaxHeight: 3840,
+	}
+
+	processorAudioInput = &MediaLimits{
+		MimeTypes:   []string{"audio/mpeg", "audio/wav", "audio/x-wav", "audio/aac", "audio/ogg", "audio/flac", "audio/mp4"},
+		MaxBytes:    100 * megabyte,
+		MaxDuration: 600,
+	}
+)
+
+// limitsFor returns the limits for a media kind
+func (c MediaConstraints) limitsFor(kind OutputType) *MediaLimits {
+	switch kind {
+	case OutputTypeImage:
+		return c.Image
+	case OutputTypeVideo:
+		return c.Video
+	case OutputTypeAudio:
+		return c.Audio
+	}
+	return nil
+}
+
+// IsZero returns true if no kind is constrained
+func (c MediaConstraints) IsZero() bool {
+	return c.Image == nil && c.Video == nil && c.Audio == nil
+}
+
+// inputMedia is one types.Input* value found in a params struct
+type inputMedia struct {
+	Path       string
+	Kind       OutputType
+	MimeType   string
+	FileSize   int64
+	Width      *int
+	Height     *int
+	Duration   *float64
+	StorageURL string
+}
+
+// collectInputMedia finds every types.Input* value in params with its JSON path
+func collectInputMedia(params interface{}) []inputMedia {
+	var found []inputMedia
+	walkInputMedia(reflect.ValueOf(params), "", &found)
+	return found
+}
+
+func walkInputMedia(v reflect.Value, path string, found *[]inputMedia) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		switch media := v.Interface().(type) {
+		case types.InputImage:
+			*found = append(*found, inputMedia{Path: path, Kind: OutputTypeImage, MimeType: media.MimeType,
+				FileSize: media.FileSize, Width: media.Width, Height: media.Height, StorageURL: media.StorageURL})
+			return
+		case types.InputVideo:
+			*found = append(*found, inputMedia{Path: path, Kind: OutputTypeVideo, MimeType: media.MimeType,
+				FileSize: media.FileSize, Width: media.Width, Height: media.Height, Duration: media.Duration,
+				StorageURL: media.StorageURL})
+			return
+		case types.InputAudio:
+			*found = append(*found, inputMedia{Path: path, Kind: OutputTypeAudio, MimeType: media.MimeType,
+				FileSize: media.FileSize, Duration: media.Duration, StorageURL: media.StorageURL})
+			return
+		}
+		for _, field := range jsonFields(v.Type()) {
+			walkInputMedia(v.FieldByName(field.GoName), joinPath(path, field.JSONName), found)
+		}
+
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			walkInputMedia(v.Index(i), fmt.Sprintf("%s[%d]", path, i), found)
+		}
+	}
+}
+
+// mediaConstraintDetails checks every input media value in params against the tool's constraints
+func mediaConstraintDetails(constraints MediaConstraints, params interface{}) []errors.ValidationDetail {
+	if constraints.IsZero() {
+		return nil
+	}
+
+	var details []errors.ValidationDetail
+	for _, media := range collectInputMedia(params) {
+		limits := constraints.limitsFor(media.Kind)
+		if limits == nil {
+			continue
+		}
+		details = append(details, limits.check(media)...)
+	}
+	return details
+}
+
+// check reports every limit the media value exceeds
+func (l *MediaLimits) check(media inputMedia) []errors.ValidationDetail {
+	var details []errors.ValidationDetail
+
+	if len(l.MimeTypes) > 0 && media.MimeType != "" && !containsFold(l.MimeTypes, media.MimeType) {
+		details = append(details, errors.ValidationDetail{
+			Field:    joinPath(media.Path, "mime_type"),
+			Provided: media.MimeType,
+			Expected: l.MimeTypes,
+			Reason:   fmt.Sprintf("Unsupported %s format '%s'", media.Kind, media.MimeType),
+			Code:     errors.MEDIA_UNSUPPORTED_FORMAT,
+		})
+	}
+
+	if l.MaxBytes > 0 && media.FileSize > l.MaxBytes {
+		details = append(details, errors.ValidationDetail{
+			Field:    joinPath(media.Path, "file_size_bytes"),
+			Provided: media.FileSize,
+			Expected: map[string]interface{}{"maximum": l.MaxBytes},
+			Reason: fmt.Sprintf("The %s is %.1f MB; the limit is %.1f MB", media.Kind,
+				float64(media.FileSize)/megabyte, float64(l.MaxBytes)/megabyte),
+			Code: errors.MEDIA_SIZE_TOO_LARGE,
+		})
+	}
+
+	if detail, ok := l.checkDimension(media, "width", media.Width, l.MinWidth, l.MaxWidth); !ok {
+		details = append(details, detail)
+	}
+	if detail, ok := l.checkDimension(media, "height", media.Height, l.MinHeight, l.MaxHeight); !ok {
+		details = append(details, detail)
+	}
+
+	if l.MaxDuration > 0 && media.Duration != nil && *media.Duration > l.MaxDuration {
+		details = append(details, errors.ValidationDetail{
+			Field:    joinPath(media.Path, "duration_seconds"),
+			Provided: *media.Duration,
+			Expected: map[string]interface{}{"maximum": l.MaxDuration},
+			Reason:   fmt.Sprintf("The %s is %gs long; the limit is %gs", media.Kind, *media.Duration, l.MaxDuration),
+			Code:     errors.MEDIA_INVALID_DURATION,
+		})
+	}
+
+	return details
+}
+
+func (l *MediaLimits) checkDimension(media inputMedia, name string, value *int, min, max int) (errors.ValidationDetail, bool) {
+	if value == nil || ((min == 0 || *value >= min) && (max == 0 || *value <= max)) {
+		return errors.ValidationDetail{}, true
+	}
+
+	bounds := make(map[string]interface{})
+	if min > 0 {
+		bounds["minimum"] = min
+	}
+	if max > 0 {
+		bounds["maximum"] = max
+	}
+	return errors.ValidationDetail{
+		Field:    joinPath(media.Path, name),
+		Provided: *value,
+		Expected: bounds,
+		Reason:   fmt.Sprintf("The %s %s of %dpx is outside the allowed range", media.Kind, name, *value),
+		Code:     errors.MEDIA_INVALID_DIMENSIONS,
+	}, false
+}
+
+// containsFold reports whether list contains s, ignoring case and MIME parameters
+func containsFold(list []string, s string) bool {
+	if idx := strings.Index(s, ";"); idx != -1 {
+		s = s[:idx]
+	}
+	s = strings.TrimSpace(s)
+	for _, item := range list {
+		if strings.EqualFold(item, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// applyMediaConstraintsToSchema publishes the limits on the tool's types.Input* definitions
+// so the model sees them as regular JSON Schema keywords
+func applyMediaConstraintsToSchema(schema map[string]interface{}, constraints MediaConstraints) {
+	defs, _ := schema["$defs"].(map[string]interface{})
+	for name, kind := range map[string]OutputType{"InputImage": OutputTypeImage, "InputVideo": OutputTypeVideo, "InputAudio": OutputTypeAudio} {
+		limits := constraints.limitsFor(kind)
+		def, _ := defs[name].(map[string]interface{})
+		if limits == nil || def == nil {
+			continue
+		}
+		props, _ := def["properties"].(map[string]interface{})
+		if props == nil {
+			continue
+		}
+
+		setKeyword := func(prop, keyword string, value interface{}) {
+			if p, ok := props[prop].(map[string]interface{}); ok {
+				p[keyword] = value
+			}
+		}
+		if len(limits.MimeTypes) > 0 {
+			// A pattern rather than an enum, so the schema accepts what check accepts
+			setKeyword("mime_type", "pattern", mimeTypePattern(limits.MimeTypes))
+			setKeyword("mime_type", "examples", append([]string(nil), limits.MimeTypes...))
+		}
+		if limits.MaxBytes > 0 {
+			setKeyword("file_size_bytes", "maximum", limits.MaxBytes)
+		}
+		if limits.MinWidth > 0 {
+			setKeyword("width", "minimum", limits.MinWidth)
+		}
+		if limits.MaxWidth > 0 {
+			setKeyword("width", "maximum", limits.MaxWidth)
+		}
+		if limits.MinHeight > 0 {
+			setKeyword("height", "minimum", limits.MinHeight)
+		}
+		if limits.MaxHeight > 0 {
+			setKeyword("height", "maximum", limits.MaxHeight)
+		}
+		if limits.MaxDuration > 0 {
+			setKeyword("duration_seconds", "maximum", limits.MaxDuration)
+		}
+	}
+}
+
+// mimeTypePattern builds an ECMA-262 pattern matching the MIME types the way
+// containsFold does: in any case, with optional parameters after a ';'.
+// ECMA-262 has no inline case-insensitive flag, so letters become [xX] classes.
+func mimeTypePattern(mimeTypes []string) string {
+	alternatives := make([]string, len(mimeTypes))
+	for i, mimeType := range mimeTypes {
+		var b strings.Builder
+		for _, r := range regexp.QuoteMeta(mimeType) {
+			if lower, upper := unicode.ToLower(r), unicode.ToUpper(r); lower != upper {
+				fmt.Fprintf(&b, "[%c%c]", lower, upper)
+			} else {
+				b.WriteRune(r)
+			}
+		}
+		alternatives[i] = b.String()
+	}
+	return `^\s*(` + strings.Join(alternatives, "|") + `)\s*(;.*)?$`
+}
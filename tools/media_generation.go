@@ -2,6 +2,8 @@ package tools
 
 import (
 	"fmt"
+
+	"github.com/metaphi-labs/latent-contracts/errors"
 	"github.com/metaphi-labs/latent-contracts/types"
 )
 
@@ -13,19 +15,19 @@ type GenerateImageImagenParams struct {
 	Seed                      *int64  `json:"seed,omitempty" validate:"omitempty,min=0,max=4294967295"`
 	Style                     string  `json:"style,omitempty"`
 	Language                  string  `json:"language,omitempty" validate:"omitempty,len=2"`
-	ImageSize                 string  `json:"image_size,omitempty" validate:"omitempty,oneof=1K 2K"`
-	AspectRatio               string  `json:"aspect_ratio,omitempty" validate:"omitempty,oneof=1:1 16:9 9:16 4:3 3:4"`
+	ImageSize                 string  `json:"image_size,omitempty" validate:"omitempty,oneof=1K 2K" default:"1K"`
+	AspectRatio               string  `json:"aspect_ratio,omitempty" validate:"omitempty,oneof=1:1 16:9 9:16 4:3 3:4" default:"1:1"`
 	AddWatermark              bool    `json:"add_watermark,omitempty"`
 	EnhancePrompt             bool    `json:"enhance_prompt,omitempty"`
-	GuidanceScale             float64 `json:"guidance_scale,omitempty" validate:"omitempty,min=1.0,max=20.0"`
+	GuidanceScale             float64 `json:"guidance_scale,omitempty" validate:"omitempty,min=1.0,max=20.0" lenient:"clamp"`
 	NegativePrompt            string  `json:"negative_prompt,omitempty" validate:"omitempty,max=500"`
-	NumberOfImages            int     `json:"number_of_images,omitempty" validate:"omitempty,min=1,max=4"`
-	OutputMimeType            string  `json:"output_mime_type,omitempty" validate:"omitempty,oneof=image/png image/jpeg"`
+	NumberOfImages            int     `json:"number_of_images,omitempty" validate:"omitempty,min=1,max=4" default:"1" lenient:"clamp"`
+	OutputMimeType            string  `json:"output_mime_type,omitempty" validate:"omitempty,oneof=image/png image/jpeg" default:"image/png"`
 	PersonGeneration          string  `json:"person_generation,omitempty" validate:"omitempty,oneof=DONT_ALLOW ALLOW_ADULT ALLOW_ALL"`
 	IncludeRaiReason          bool    `json:"include_rai_reason,omitempty"`
 	SafetyFilterLevel         string  `json:"safety_filter_level,omitempty" validate:"omitempty,oneof=BLOCK_MEDIUM_AND_ABOVE BLOCK_MOST BLOCK_SOME BLOCK_FEW"`
 	IncludeSafetyAttributes   bool    `json:"include_safety_attributes,omitempty"`
-	OutputCompressionQuality  int     `json:"output_compression_quality,omitempty" validate:"omitempty,min=1,max=100"`
+	OutputCompressionQuality  int     `json:"output_compression_quality,omitempty" validate:"omitempty,min=1,max=100" lenient:"clamp"`
 }
 
 // GenerateImageImagenFastParams for generate-image-imagen-fast tool
@@ -34,19 +36,19 @@ type GenerateImageImagenFastParams struct {
 	Seed                      *int64  `json:"seed,omitempty" validate:"omitempty,min=0,max=4294967295"`
 	Style                     string  `json:"style,omitempty"`
 	Language                  string  `json:"language,omitempty" validate:"omitempty,len=2"`
-	ImageSize                 string  `json:"image_size,omitempty" validate:"omitempty,eq=1K"`
-	AspectRatio               string  `json:"aspect_ratio,omitempty" validate:"omitempty,oneof=1:1 16:9 9:16 4:3 3:4"`
+	ImageSize                 string  `json:"image_size,omitempty" validate:"omitempty,eq=1K" default:"1K"`
+	AspectRatio               string  `json:"aspect_ratio,omitempty" validate:"omitempty,oneof=1:1 16:9 9:16 4:3 3:4" default:"1:1"`
 	AddWatermark              bool    `json:"add_watermark,omitempty"`
 	EnhancePrompt             bool    `json:"enhance_prompt,omitempty"`
-	GuidanceScale             float64 `json:"guidance_scale,omitempty" validate:"omitempty,min=1.0,max=20.0"`
+	GuidanceScale             float64 `json:"guidance_scale,omitempty" validate:"omitempty,min=1.0,max=20.0" lenient:"clamp"`
 	NegativePrompt            string  `json:"negative_prompt,omitempty" validate:"omitempty,max=500"`
-	NumberOfImages            int     `json:"number_of_images,omitempty" validate:"omitempty,min=1,max=4"`
-	OutputMimeType            string  `json:"output_mime_type,omitempty" validate:"omitempty,oneof=image/png image/jpeg"`
+	NumberOfImages            int     `json:"number_of_images,omitempty" validate:"omitempty,min=1,max=4" default:"1" lenient:"clamp"`
+	OutputMimeType            string  `json:"output_mime_type,omitempty" validate:"omitempty,oneof=image/png image/jpeg" default:"image/png"`
 	PersonGeneration          string  `json:"person_generation,omitempty" validate:"omitempty,oneof=DONT_ALLOW ALLOW_ADULT ALLOW_ALL"`
 	IncludeRaiReason          bool    `json:"include_rai_reason,omitempty"`
 	SafetyFilterLevel         string  `json:"safety_filter_level,omitempty" validate:"omitempty,oneof=BLOCK_MEDIUM_AND_ABOVE BLOCK_MOST BLOCK_SOME BLOCK_FEW"`
 	IncludeSafetyAttributes   bool    `json:"include_safety_attributes,omitempty"`
-	OutputCompressionQuality  int     `json:"output_compression_quality,omitempty" validate:"omitempty,min=1,max=100"`
+	OutputCompressionQuality  int     `json:"output_compression_quality,omitempty" validate:"omitempty,min=1,max=100" lenient:"clamp"`
 }
 
 // GenerateImageImagenUltraParams for generate-image-imagen-ultra tool
@@ -55,19 +57,19 @@ type GenerateImageImagenUltraParams struct {
 	Seed                      *int64  `json:"seed,omitempty" validate:"omitempty,min=0,max=4294967295"`
 	Style                     string  `json:"style,omitempty"`
 	Language                  string  `json:"language,omitempty" validate:"omitempty,len=2"`
-	ImageSize                 string  `json:"image_size,omitempty" validate:"omitempty,oneof=1K 2K"`
-	AspectRatio               string  `json:"aspect_ratio,omitempty" validate:"omitempty,oneof=1:1 16:9 9:16 4:3 3:4"`
+	ImageSize                 string  `json:"image_size,omitempty" validate:"omitempty,oneof=1K 2K" default:"1K"`
+	AspectRatio               string  `json:"aspect_ratio,omitempty" validate:"omitempty,oneof=1:1 16:9 9:16 4:3 3:4" default:"1:1"`
 	AddWatermark              bool    `json:"add_watermark,omitempty"`
 	EnhancePrompt             bool    `json:"enhance_prompt,omitempty"`
-	GuidanceScale             float64 `json:"guidance_scale,omitempty" validate:"omitempty,min=1.0,max=20.0"`
+	GuidanceScale             float64 `json:"guidance_scale,omitempty" validate:"omitempty,min=1.0,max=20.0" lenient:"clamp"`
 	NegativePrompt            string  `json:"negative_prompt,omitempty" validate:"omitempty,max=500"`
-	NumberOfImages            int     `json:"number_of_images,omitempty" validate:"omitempty,eq=1"`
-	OutputMimeType            string  `json:"output_mime_type,omitempty" validate:"omitempty,oneof=image/png image/jpeg"`
+	NumberOfImages            int     `json:"number_of_images,omitempty" validate:"omitempty,eq=1" default:"1"`
+	OutputMimeType            string  `json:"output_mime_type,omitempty" validate:"omitempty,oneof=image/png image/jpeg" default:"image/png"`
 	PersonGeneration          string  `json:"person_generation,omitempty" validate:"omitempty,oneof=DONT_ALLOW ALLOW_ADULT ALLOW_ALL"`
 	IncludeRaiReason          bool    `json:"include_rai_reason,omitempty"`
 	SafetyFilterLevel         string  `json:"safety_filter_level,omitempty" validate:"omitempty,oneof=BLOCK_MEDIUM_AND_ABOVE BLOCK_MOST BLOCK_SOME BLOCK_FEW"`
 	IncludeSafetyAttributes   bool    `json:"include_safety_attributes,omitempty"`
-	OutputCompressionQuality  int     `json:"output_compression_quality,omitempty" validate:"omitempty,min=1,max=100"`
+	OutputCompressionQuality  int     `json:"output_compression_quality,omitempty" validate:"omitempty,min=1,max=100" lenient:"clamp"`
 }
 
 // === Multimodal Image Generation ===
@@ -79,13 +81,13 @@ type NanoBananaParams struct {
 	Prompt string `json:"prompt" validate:"required,min=1,max=8000"`
 
 	// Input images for editing, style transfer, or composition (up to 3 recommended)
-	InputImages []types.InputImage `json:"images,omitempty" validate:"omitempty,max=3"` // Input images for editing/composition
-	Context []ContextMessage `json:"context,omitempty" validate:"omitempty,max=10"` // Conversation history for multi-turn
+	InputImages []types.InputImage `json:"images,omitempty" validate:"omitempty,max=3,dive"` // Input images for editing/composition
+	Context []ContextMessage `json:"context,omitempty" validate:"omitempty,max=10,dive"` // Conversation history for multi-turn
 
 	// Generation parameters
-	Temperature     *float64 `json:"temperature,omitempty" validate:"omitempty,min=0,max=2"`
-	TopP            *float64 `json:"top_p,omitempty" validate:"omitempty,min=0,max=1"`
-	NumberOfImages  int      `json:"number_of_images,omitempty" validate:"omitempty,min=1,max=4"`
+	Temperature     *float64 `json:"temperature,omitempty" validate:"omitempty,min=0,max=2" lenient:"clamp"`
+	TopP            *float64 `json:"top_p,omitempty" validate:"omitempty,min=0,max=1" lenient:"clamp"`
+	NumberOfImages  int      `json:"number_of_images,omitempty" validate:"omitempty,min=1,max=4" default:"1" lenient:"clamp"`
 
 	// Safety settings
 	SafetyFilterLevel string `json:"safety_filter_level,omitempty" validate:"omitempty,oneof=BLOCK_NONE BLOCK_ONLY_HIGH BLOCK_MEDIUM_AND_ABOVE"`
@@ -97,19 +99,22 @@ type NanoBananaParams struct {
 
 // Validate ensures NanoBananaParams is well-formed
 func (n *NanoBananaParams) Validate() error {
-	// Prompt is required
-	if n.Prompt == "" {
-		return fmt.Errorf("prompt is required")
-	}
-
-	// Validate context messages if provided
+	// Message parts need text or an image, which tags can't express
+	var details []errors.ValidationDetail
 	for i, msg := range n.Context {
-		if err := msg.Validate(); err != nil {
-			return fmt.Errorf("context[%d]: %w", i, err)
+		for j, part := range msg.Parts {
+			if err := part.Validate(); err != nil {
+				details = append(details, errors.ValidationDetail{
+					Field:    fmt.Sprintf("context[%d].parts[%d]", i, j),
+					Expected: []string{"text", "storage_url"},
+					Reason:   err.Error(),
+					Code:     errors.VAL_MISSING_PARAMETER,
+				})
+			}
 		}
 	}
 
-	return nil
+	return checkParams(n, details...)
 }
 
 // === Video Generation Tools (Veo3) ===
@@ -120,11 +125,11 @@ type GenerateVideoVeo3Params struct {
 	Image               *types.InputImage `json:"image,omitempty" validate:"required_without=Prompt"`
 	LastFrame           *types.InputImage `json:"last_frame,omitempty"`
 	Seed                *int64          `json:"seed,omitempty" validate:"omitempty,min=0,max=4294967295"`
-	FPS                 int             `json:"fps,omitempty" validate:"omitempty,oneof=24 30 60"`
-	Duration            int             `json:"duration,omitempty" validate:"omitempty,min=5,max=8"`
-	Resolution          string          `json:"resolution,omitempty" validate:"omitempty,oneof=720p 1080p"`
+	FPS                 int             `json:"fps,omitempty" validate:"omitempty,oneof=24 30 60" default:"24"`
+	Duration            int             `json:"duration,omitempty" validate:"omitempty,min=5,max=8" default:"8" lenient:"clamp"`
+	Resolution          string          `json:"resolution,omitempty" validate:"omitempty,oneof=720p 1080p" default:"720p"`
 	AspectRatio         string          `json:"aspect_ratio,omitempty" validate:"omitempty,oneof=16:9 9:16"`
-	SampleCount         int             `json:"sample_count,omitempty" validate:"omitempty,min=1,max=4"`
+	SampleCount         int             `json:"sample_count,omitempty" validate:"omitempty,min=1,max=4" default:"1" lenient:"clamp"`
 	EnhancePrompt       bool            `json:"enhance_prompt,omitempty"`
 	GenerateAudio       bool            `json:"generate_audio,omitempty"`
 	NegativePrompt      string          `json:"negative_prompt,omitempty" validate:"omitempty,max=500"`
@@ -136,10 +141,10 @@ type GenerateVideoVeo3Params struct {
 type GenerateVideoVeo3FastParams struct {
 	Prompt              string  `json:"prompt" validate:"required,min=10,max=2000"`
 	Seed                *int64  `json:"seed,omitempty" validate:"omitempty,min=0,max=4294967295"`
-	FPS                 int     `json:"fps,omitempty" validate:"omitempty,oneof=24 30 60"`
-	Duration            int     `json:"duration,omitempty" validate:"omitempty,min=5,max=8"`
+	FPS                 int     `json:"fps,omitempty" validate:"omitempty,oneof=24 30 60" default:"24"`
+	Duration            int     `json:"duration,omitempty" validate:"omitempty,min=5,max=8" default:"8" lenient:"clamp"`
 	AspectRatio         string  `json:"aspect_ratio,omitempty" validate:"omitempty,oneof=16:9 9:16"`
-	SampleCount         int     `json:"sample_count,omitempty" validate:"omitempty,min=1,max=4"`
+	SampleCount         int     `json:"sample_count,omitempty" validate:"omitempty,min=1,max=4" default:"1" lenient:"clamp"`
 	EnhancePrompt       bool    `json:"enhance_prompt,omitempty"`
 	GenerateAudio       bool    `json:"generate_audio,omitempty"`
 	NegativePrompt      string  `json:"negative_prompt,omitempty" validate:"omitempty,max=500"`
@@ -151,10 +156,10 @@ type GenerateVideoVeo3FastParams struct {
 type GenerateVideoVeo3FastNoAudioParams struct {
 	Prompt              string  `json:"prompt" validate:"required,min=10,max=2000"`
 	Seed                *int64  `json:"seed,omitempty" validate:"omitempty,min=0,max=4294967295"`
-	FPS                 int     `json:"fps,omitempty" validate:"omitempty,oneof=24 30 60"`
-	Duration            int     `json:"duration,omitempty" validate:"omitempty,min=5,max=8"`
+	FPS                 int     `json:"fps,omitempty" validate:"omitempty,oneof=24 30 60" default:"24"`
+	Duration            int     `json:"duration,omitempty" validate:"omitempty,min=5,max=8" default:"8" lenient:"clamp"`
 	AspectRatio         string  `json:"aspect_ratio,omitempty" validate:"omitempty,oneof=16:9 9:16"`
-	SampleCount         int     `json:"sample_count,omitempty" validate:"omitempty,min=1,max=4"`
+	SampleCount         int     `json:"sample_count,omitempty" validate:"omitempty,min=1,max=4" default:"1" lenient:"clamp"`
 	EnhancePrompt       bool    `json:"enhance_prompt,omitempty"`
 	NegativePrompt      string  `json:"negative_prompt,omitempty" validate:"omitempty,max=500"`
 	PersonGeneration    string  `json:"person_generation,omitempty" validate:"omitempty,oneof=allow_adult dont_allow"`
@@ -167,11 +172,11 @@ type GenerateVideoVeo3NoAudioParams struct {
 	Image               *types.InputImage `json:"image,omitempty" validate:"required_without=Prompt"`
 	LastFrame           *types.InputImage `json:"last_frame,omitempty"`
 	Seed                *int64          `json:"seed,omitempty" validate:"omitempty,min=0,max=4294967295"`
-	FPS                 int             `json:"fps,omitempty" validate:"omitempty,oneof=24 30 60"`
-	Duration            int             `json:"duration,omitempty" validate:"omitempty,min=5,max=8"`
-	Resolution          string          `json:"resolution,omitempty" validate:"omitempty,oneof=720p 1080p"`
+	FPS                 int             `json:"fps,omitempty" validate:"omitempty,oneof=24 30 60" default:"24"`
+	Duration            int             `json:"duration,omitempty" validate:"omitempty,min=5,max=8" default:"8" lenient:"clamp"`
+	Resolution          string          `json:"resolution,omitempty" validate:"omitempty,oneof=720p 1080p" default:"720p"`
 	AspectRatio         string          `json:"aspect_ratio,omitempty" validate:"omitempty,oneof=16:9 9:16"`
-	SampleCount         int             `json:"sample_count,omitempty" validate:"omitempty,min=1,max=4"`
+	SampleCount         int             `json:"sample_count,omitempty" validate:"omitempty,min=1,max=4" default:"1" lenient:"clamp"`
 	EnhancePrompt       bool            `json:"enhance_prompt,omitempty"`
 	NegativePrompt      string          `json:"negative_prompt,omitempty" validate:"omitempty,max=500"`
 	PersonGeneration    string          `json:"person_generation,omitempty" validate:"omitempty,oneof=allow_adult dont_allow"`
@@ -184,6 +189,6 @@ type GenerateVideoVeo3NoAudioParams struct {
 type GenerateMusicLyriaParams struct {
 	Prompt         string `json:"prompt" validate:"required"`
 	Seed           *int   `json:"seed,omitempty"`
-	SampleCount    int    `json:"sample_count,omitempty" validate:"omitempty,min=1,max=4"`
+	SampleCount    int    `json:"sample_count,omitempty" validate:"omitempty,min=1,max=4" default:"1" lenient:"clamp"`
 	NegativePrompt string `json:"negative_prompt,omitempty"`
 }
\ No newline at end of file
@@ -0,0 +1,63 @@
+package tools
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RateLimitPolicy caps how often and how many jobs of a tool run at once.
+// Zero fields are unlimited. See the ratelimit package for the limiter enforcing it.
+type RateLimitPolicy struct {
+	UserRPM          int    // Calls per user per minute
+	UserConcurrent   int    // Jobs per user in flight at once
+	GlobalConcurrent int    // Jobs across all users in flight at once, e.g. the provider's quota
+	Group            string // Tools sharing a group share these limits, e.g. the Veo3 variants; defaults to the tool name
+}
+
+// Limited reports whether any limit is set
+func (p RateLimitPolicy) Limited() bool {
+	return p.UserRPM > 0 || p.UserConcurrent > 0 || p.GlobalConcurrent > 0
+}
+
+// Key returns the name limits are counted under: the group, or the tool itself
+func (p RateLimitPolicy) Key(tool ToolName) string {
+	if p.Group != "" {
+		return p.Group
+	}
+	return string(tool)
+}
+
+// Describe summarizes the limits for docs, e.g. "4/min per user; 2 concurrent per user"
+func (p RateLimitPolicy) Describe() string {
+	var parts []string
+	if p.UserRPM > 0 {
+		parts = append(parts, fmt.Sprintf("%d/min per user", p.UserRPM))
+	}
+	if p.UserConcurrent > 0 {
+		parts = append(parts, fmt.Sprintf("%d concurrent per user", p.UserConcurrent))
+	}
+	if p.GlobalConcurrent > 0 {
+		parts = append(parts, fmt.Sprintf("%d concurrent overall", p.GlobalConcurrent))
+	}
+	description := strings.Join(parts, "; ")
+	if p.Group != "" && description != "" {
+		description += fmt.Sprintf(" (shared by group %s)", p.Group)
+	}
+	return description
+}
+
+// validateRateLimit checks the policy of a tool being registered
+func validateRateLimit(p RateLimitPolicy) error {
+	if p.UserRPM < 0 || p.UserConcurrent < 0 || p.GlobalConcurrent < 0 {
+		return fmt.Errorf("rate limits must not be negative")
+	}
+	return nil
+}
+
+// Limits shared by the Veo3 variants, which draw on one provider quota
+var veo3RateLimit = RateLimitPolicy{
+	UserRPM:          4,
+	UserConcurrent:   2,
+	GlobalConcurrent: 10,
+	Group:            "veo3",
+}
@@ -0,0 +1,245 @@
+package tools
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Recommendation is one candidate tool for an utterance
+type Recommendation struct {
+	Tool    ToolName `json:"tool"`
+	Score   float64  `json:"score"`
+	Credits int      `json:"credits"`
+	Terms   []string `json:"terms,omitempty"` // Query terms that matched the tool
+}
+
+// RecommendOptions tunes RecommendTools
+type RecommendOptions struct {
+	Limit             int // Defaults to 5
+	IncludeDeprecated bool
+	Filters           []ToolFilter // e.g. AvailableTo(caller)
+}
+
+// BM25 parameters and ranking knobs
+const (
+	bm25K1           = 1.2
+	bm25B            = 0.75
+	recommendLimit   = 5
+	recommendTieBand = 0.15 // Scores within 15% of each other count as a tie for cost tie-breaking
+)
+
+// Weights of each part of a tool's text in its BM25 document
+var recommendWeights = struct{ name, description, examples, fields, output float64 }{
+	name: 2, description: 1, examples: 1, fields: 0.5, output: 1,
+}
+
+// Query words that ask for cheaper or better results; they decide near-ties by credits
+var (
+	budgetCues  = []string{"draft", "quick", "fast", "cheap", "rough", "sketch", "preview", "test", "iteration", "budget"}
+	premiumCues = []string{"best", "premium", "highest", "final", "professional", "hero", "ultra", "production"}
+)
+
+// recommendSynonyms expand user words onto the vocabulary tool descriptions use
+var recommendSynonyms = map[string][]string{
+	"photo": {"image"}, "picture": {"image"}, "pic": {"image"}, "illustration": {"image"}, "drawing": {"image"},
+	"clip": {"video"}, "movie": {"video"}, "film": {"video"}, "animation": {"video"}, "animate": {"video"},
+	"song": {"music"}, "track": {"music"}, "tune": {"music"}, "melody": {"music"}, "soundtrack": {"music"},
+	"cut": {"trim"}, "shorten": {"trim"}, "join": {"combine"}, "stitch": {"combine"}, "concatenate": {"combine"},
+	"screenshot": {"frame"}, "still": {"frame"}, "thumbnail": {"frame"}, "slideshow": {"image", "video"},
+	"google": {"search"}, "lookup": {"search"}, "website": {"web", "page"}, "url": {"web", "page"},
+}
+
+var recommendStopwords = map[string]bool{
+	"a": true, "an": true, "the": true, "of": true, "for": true, "and": true, "or": true, "to": true,
+	"with": true, "in": true, "on": true, "at": true, "by": true, "me": true, "my": true, "i": true,
+	"you": true, "can": true, "please": true, "some": true, "it": true, "is": true, "this": true, "that": true,
+	"be": true, "from": true, "into": true, "using": true, "use": true, "want": true, "need": true, "like": true,
+}
+
+// RecommendTools ranks registered tools against a user utterance with BM25 over their
+// names, descriptions, examples and param field names, and returns the top candidates.
+// Near-ties are broken by cost when the utterance asks for a draft or for the best
+// quality. Use it to shortlist the function declarations sent to a model.
+func RecommendTools(utterance string, opts RecommendOptions) []Recommendation {
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = recommendLimit
+	}
+
+	var docs []recommendDoc
+	for _, name := range RegisteredTools() {
+		if IsDeprecated(name) && !opts.IncludeDeprecated || !passes(name, opts.Filters) {
+			continue
+		}
+		def, _ := LookupTool(name)
+		docs = append(docs, newRecommendDoc(def))
+	}
+	if len(docs) == 0 {
+		return nil
+	}
+
+	words := recommendTokens(utterance)
+	query := expandQuery(words)
+	ranked := rankBM25(docs, query)
+	breakCostTies(ranked, costPreference(words))
+
+	if len(ranked) > limit {
+		ranked = ranked[:limit]
+	}
+	return ranked
+}
+
+// recommendDoc is a tool's weighted term frequencies
+type recommendDoc struct {
+	tool    ToolName
+	credits int
+	terms   map[string]float64
+	length  float64
+}
+
+func newRecommendDoc(def ToolDefinition) recommendDoc {
+	doc := recommendDoc{tool: def.Name, credits: def.Meta.Credits, terms: make(map[string]float64)}
+	add := func(text string, weight float64) {
+		for _, term := range recommendTokens(text) {
+			doc.terms[term] += weight
+			doc.length += weight
+		}
+	}
+	add(strings.ReplaceAll(string(def.Name), "-", " "), recommendWeights.name)
+	add(def.Meta.Description, recommendWeights.description)
+	for _, example := range def.Meta.Examples {
+		add(example, recommendWeights.examples)
+	}
+	for _, field := range jsonFields(def.ParamsType) {
+		add(strings.ReplaceAll(field.JSONName, "_", " "), recommendWeights.fields)
+	}
+	add(string(def.Meta.OutputType), recommendWeights.output)
+	return doc
+}
+
+// rankBM25 scores every document against the query and returns the matches, best first
+func rankBM25(docs []recommendDoc, query []string) []Recommendation {
+	avgLength := 0.0
+	for _, doc := range docs {
+		avgLength += doc.length
+	}
+	avgLength /= float64(len(docs))
+
+	var ranked []Recommendation
+	for _, doc := range docs {
+		score := 0.0
+		var matched []string
+		for _, term := range query {
+			tf := doc.terms[term]
+			if tf == 0 {
+				continue
+			}
+			df := 0
+			for _, other := range docs {
+				if other.terms[term] > 0 {
+					df++
+				}
+			}
+			idf := math.Log(1 + (float64(len(docs))-float64(df)+0.5)/(float64(df)+0.5))
+			score += idf * tf * (bm25K1 + 1) / (tf + bm25K1*(1-bm25B+bm25B*doc.length/avgLength))
+			matched = append(matched, term)
+		}
+		if score > 0 {
+			ranked = append(ranked, Recommendation{Tool: doc.tool, Score: score, Credits: doc.credits, Terms: matched})
+		}
+	}
+	sort.SliceStable(ranked, func(i, j int) bool { return ranked[i].Score > ranked[j].Score })
+	return ranked
+}
+
+// costPreference returns -1 when the words ask for cheaper results, 1 for premium ones, else 0
+func costPreference(words []string) int {
+	budget, premium := 0, 0
+	for _, word := range words {
+		for _, cue := range budgetCues {
+			if word == stemToken(cue) {
+				budget++
+			}
+		}
+		for _, cue := range premiumCues {
+			if word == stemToken(cue) {
+				premium++
+			}
+		}
+	}
+	switch {
+	case budget > premium:
+		return -1
+	case premium > budget:
+		return 1
+	}
+	return 0
+}
+
+// breakCostTies reorders runs of near-equal scores by credits: cheapest first for
+// preference -1, most expensive first for 1. Scores are left untouched.
+func breakCostTies(ranked []Recommendation, preference int) {
+	if preference == 0 {
+		return
+	}
+	for start := 0; start < len(ranked); {
+		end := start + 1
+		for end < len(ranked) && ranked[end].Score >= ranked[start].Score*(1-recommendTieBand) {
+			end++
+		}
+		group := ranked[start:end]
+		sort.SliceStable(group, func(i, j int) bool {
+			if preference < 0 {
+				return group[i].Credits < group[j].Credits
+			}
+			return group[i].Credits > group[j].Credits
+		})
+		start = end
+	}
+}
+
+// expandQuery adds synonyms and drops duplicate terms
+func expandQuery(words []string) []string {
+	seen := make(map[string]bool)
+	var query []string
+	add := func(term string) {
+		if !seen[term] {
+			seen[term] = true
+			query = append(query, term)
+		}
+	}
+	for _, word := range words {
+		add(word)
+		for _, synonym := range recommendSynonyms[word] {
+			add(stemToken(synonym))
+		}
+	}
+	return query
+}
+
+// recommendTokens lowercases text, splits it into words, drops stopwords and stems
+func recommendTokens(text string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	var tokens []string
+	for _, field := range fields {
+		if recommendStopwords[field] {
+			continue
+		}
+		tokens = append(tokens, stemToken(field))
+	}
+	return tokens
+}
+
+// stemToken strips common English suffixes so "videos" matches "video" and "drafts" matches "draft"
+func stemToken(word string) string {
+	for _, suffix := range []string{"ing", "es", "s"} {
+		if strings.HasSuffix(word, suffix) && len(word)-len(suffix) >= 4 && !strings.HasSuffix(word, "ss") {
+			return strings.TrimSuffix(word, suffix)
+		}
+	}
+	return word
+}
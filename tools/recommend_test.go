@@ -0,0 +1,45 @@
+package tools
+
+import "testing"
+
+func TestRecommendTools(t *testing.T) {
+	cases := []struct {
+		utterance string
+		want      ToolName
+	}{
+		{"quick draft image", GenerateImageImagenFast},
+		{"best quality video", GenerateVideoVeo3},
+		{"combine clips", CombineVideos},
+	}
+	for _, tc := range cases {
+		recommended := RecommendTools(tc.utterance, RecommendOptions{})
+		if len(recommended) == 0 {
+			t.Errorf("%q: no recommendations", tc.utterance)
+			continue
+		}
+		if recommended[0].Tool != tc.want {
+			t.Errorf("%q: top recommendation %s, want %s", tc.utterance, recommended[0].Tool, tc.want)
+		}
+	}
+}
+
+func TestRecommendToolsOptions(t *testing.T) {
+	if recommended := RecommendTools("generate an image", RecommendOptions{Limit: 2}); len(recommended) != 2 {
+		t.Errorf("%d recommendations, want the limit of 2", len(recommended))
+	}
+
+	free := AvailableTo(CallerContext{Tier: TierFree})
+	recommended := RecommendTools("cinematic video", RecommendOptions{Filters: []ToolFilter{free}})
+	if len(recommended) == 0 {
+		t.Error("no recommendations for a free caller")
+	}
+	for _, r := range recommended {
+		if meta, _ := GetToolMetadata(r.Tool); meta.Availability.MinTier == TierPro {
+			t.Errorf("recommended %s to a free caller", r.Tool)
+		}
+	}
+
+	if recommended := RecommendTools("the of and", RecommendOptions{}); len(recommended) != 0 {
+		t.Errorf("stopwords matched %v", recommended)
+	}
+}
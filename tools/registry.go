@@ -1,8 +1,9 @@
 package tools
 
 import (
-	"encoding/json"
 	"fmt"
+	"reflect"
+	"sync"
 )
 
 // ToolName represents valid tool names as constants
@@ -33,151 +34,177 @@ const (
 	GoogleSearch    ToolName = "google-search"
 )
 
-// ParseAndValidateParams takes raw params and validates them for a specific tool
-func ParseAndValidateParams(toolName string, params map[string]interface{}) error {
-	// Convert map to JSON for validation
-	jsonBytes, err := json.Marshal(params)
-	if err != nil {
-		return fmt.Errorf("failed to marshal params: %w", err)
-	}
-
-	// Parse based on tool name
-	switch ToolName(toolName) {
-	case GenerateImageImagen:
-		var p GenerateImageImagenParams
-		if err := json.Unmarshal(jsonBytes, &p); err != nil {
-			return fmt.Errorf("invalid params for %s: %w", toolName, err)
-		}
-		return p.Validate()
+// ResultKind identifies which payload of results.ToolResult a tool produces
+type ResultKind string
 
-	case GenerateImageImagenFast:
-		var p GenerateImageImagenFastParams
-		if err := json.Unmarshal(jsonBytes, &p); err != nil {
-			return fmt.Errorf("invalid params for %s: %w", toolName, err)
-		}
-		return p.Validate()
+const (
+	ResultKindMediaGeneration ResultKind = "media_generation"
+	ResultKindVideoProcessing ResultKind = "video_processing"
+	ResultKindNative          ResultKind = "native" // Inline text/JSON answer, no result payload
+)
 
-	case GenerateImageImagenUltra:
-		var p GenerateImageImagenUltraParams
-		if err := json.Unmarshal(jsonBytes, &p); err != nil {
-			return fmt.Errorf("invalid params for %s: %w", toolName, err)
-		}
-		return p.Validate()
+// Validatable is implemented by tool params structs that carry their own validation
+type Validatable interface {
+	Validate() error
+}
 
-	case NanoBanana:
-		var p NanoBananaParams
-		if err := json.Unmarshal(jsonBytes, &p); err != nil {
-			return fmt.Errorf("invalid params for %s: %w", toolName, err)
-		}
-		return p.Validate()
+// ToolDefinition is the single declaration of a tool.
+// Every lookup (params parsing, schemas, metadata, signing) reads from the registry
+// built out of these definitions, so adding a tool means adding one definition.
+type ToolDefinition struct {
+	Name       ToolName
+	ParamsType reflect.Type // Params struct type, e.g. reflect.TypeOf(TrimVideoParams{})
+	Meta       ToolMeta
+	Signable   []string // JSON fields holding storage URLs that need signing
+	ResultKind ResultKind
+	Aliases    []ToolName     // Other names that resolve to this tool
+	Migrate    ParamMigration // Upgrades params to Meta.Successor's params; nil if they are compatible
+}
 
-	case GenerateVideoVeo3:
-		var p GenerateVideoVeo3Params
-		if err := json.Unmarshal(jsonBytes, &p); err != nil {
-			return fmt.Errorf("invalid params for %s: %w", toolName, err)
-		}
-		return p.Validate()
+// registry holds all registered tool definitions in registration order
+type registry struct {
+	mu      sync.RWMutex
+	defs    map[ToolName]ToolDefinition
+	order   []ToolName
+	aliases map[ToolName]ToolName
+}
 
-	case GenerateVideoVeo3Fast:
-		var p GenerateVideoVeo3FastParams
-		if err := json.Unmarshal(jsonBytes, &p); err != nil {
-			return fmt.Errorf("invalid params for %s: %w", toolName, err)
-		}
-		return p.Validate()
+var toolRegistry = &registry{
+	defs:    make(map[ToolName]ToolDefinition),
+	aliases: make(map[ToolName]ToolName),
+}
 
-	case GenerateVideoVeo3FastNoAudio:
-		var p GenerateVideoVeo3FastNoAudioParams
-		if err := json.Unmarshal(jsonBytes, &p); err != nil {
-			return fmt.Errorf("invalid params for %s: %w", toolName, err)
-		}
-		return p.Validate()
+func init() {
+	for _, def := range builtinDefinitions {
+		MustRegister(def)
+	}
 
-	case GenerateVideoVeo3NoAudio:
-		var p GenerateVideoVeo3NoAudioParams
-		if err := json.Unmarshal(jsonBytes, &p); err != nil {
-			return fmt.Errorf("invalid params for %s: %w", toolName, err)
+	// The deprecated exported maps are filled once, before anything can read them,
+	// and never written again
+	for _, name := range RegisteredTools() {
+		def, _ := LookupTool(name)
+		Metadata[name] = def.Meta
+		if len(def.Signable) > 0 {
+			SignableFields[name] = def.Signable
 		}
-		return p.Validate()
+	}
+}
 
-	case GenerateMusicLyria:
-		var p GenerateMusicLyriaParams
-		if err := json.Unmarshal(jsonBytes, &p); err != nil {
-			return fmt.Errorf("invalid params for %s: %w", toolName, err)
-		}
-		return p.Validate()
+// Register adds a tool definition to the registry.
+// Services may call this at runtime to add experimental tools without forking the contracts.
+func Register(def ToolDefinition) error {
+	if def.Name == "" {
+		return fmt.Errorf("tool name is required")
+	}
+	if def.ParamsType == nil {
+		return fmt.Errorf("tool %s: params type is required", def.Name)
+	}
+	if def.ParamsType.Kind() == reflect.Ptr {
+		def.ParamsType = def.ParamsType.Elem()
+	}
+	if def.ParamsType.Kind() != reflect.Struct {
+		return fmt.Errorf("tool %s: params type must be a struct, got %s", def.Name, def.ParamsType.Kind())
+	}
+	if def.Meta.Name == "" {
+		def.Meta.Name = def.Name
+	}
+	if def.Meta.Name != def.Name {
+		return fmt.Errorf("tool %s: metadata name %q does not match", def.Name, def.Meta.Name)
+	}
+	if err := validateAvailability(def.Meta.Availability); err != nil {
+		return fmt.Errorf("tool %s: %w", def.Name, err)
+	}
+	if err := validateRateLimit(def.Meta.RateLimit); err != nil {
+		return fmt.Errorf("tool %s: %w", def.Name, err)
+	}
+	if err := validateDuration(def.Meta.Duration); err != nil {
+		return fmt.Errorf("tool %s: %w", def.Name, err)
+	}
 
-	case CombineVideos:
-		var p CombineVideosParams
-		if err := json.Unmarshal(jsonBytes, &p); err != nil {
-			return fmt.Errorf("invalid params for %s: %w", toolName, err)
-		}
-		return p.Validate()
+	toolRegistry.mu.Lock()
+	defer toolRegistry.mu.Unlock()
 
-	case TrimVideo:
-		var p TrimVideoParams
-		if err := json.Unmarshal(jsonBytes, &p); err != nil {
-			return fmt.Errorf("invalid params for %s: %w", toolName, err)
+	if _, exists := toolRegistry.defs[def.Name]; exists {
+		return fmt.Errorf("tool %s is already registered", def.Name)
+	}
+	if target, exists := toolRegistry.aliases[def.Name]; exists {
+		return fmt.Errorf("tool %s is already an alias for %s", def.Name, target)
+	}
+	// Check every alias first so a conflict leaves the registry untouched
+	for _, alias := range def.Aliases {
+		if err := toolRegistry.checkAlias(alias, def.Name); err != nil {
+			return fmt.Errorf("tool %s: %w", def.Name, err)
 		}
-		return p.Validate()
+	}
+	toolRegistry.defs[def.Name] = def
+	toolRegistry.order = append(toolRegistry.order, def.Name)
+	for _, alias := range def.Aliases {
+		toolRegistry.aliases[alias] = def.Name
+	}
+	return nil
+}
 
-	case ImageAudioMerge:
-		var p ImageAudioMergeParams
-		if err := json.Unmarshal(jsonBytes, &p); err != nil {
-			return fmt.Errorf("invalid params for %s: %w", toolName, err)
-		}
-		return p.Validate()
+// MustRegister is like Register but panics on error.
+// Intended for package-level registration of known tools.
+func MustRegister(def ToolDefinition) {
+	if err := Register(def); err != nil {
+		panic(err)
+	}
+}
 
-	case ExtractFrame:
-		var p ExtractFrameParams
-		if err := json.Unmarshal(jsonBytes, &p); err != nil {
-			return fmt.Errorf("invalid params for %s: %w", toolName, err)
-		}
-		return p.Validate()
+// LookupTool returns the registered definition for a tool
+func LookupTool(name ToolName) (ToolDefinition, bool) {
+	toolRegistry.mu.RLock()
+	defer toolRegistry.mu.RUnlock()
+	def, exists := toolRegistry.defs[name]
+	return def, exists
+}
 
-	case MergeImages:
-		var p MergeImagesParams
-		if err := json.Unmarshal(jsonBytes, &p); err != nil {
-			return fmt.Errorf("invalid params for %s: %w", toolName, err)
-		}
-		return p.Validate()
+// RegisteredTools returns all registered tool names in registration order
+func RegisteredTools() []ToolName {
+	toolRegistry.mu.RLock()
+	defer toolRegistry.mu.RUnlock()
+	names := make([]ToolName, len(toolRegistry.order))
+	copy(names, toolRegistry.order)
+	return names
+}
 
-	case ImagesToVideo:
-		var p ImagesToVideoParams
-		if err := json.Unmarshal(jsonBytes, &p); err != nil {
-			return fmt.Errorf("invalid params for %s: %w", toolName, err)
-		}
-		return p.Validate()
+// NewParams returns a pointer to a zero value of the tool's params struct
+func NewParams(name ToolName) (interface{}, error) {
+	def, exists := LookupTool(name)
+	if !exists {
+		return nil, fmt.Errorf("unknown tool: %s", name)
+	}
+	return reflect.New(def.ParamsType).Interface(), nil
+}
 
-	case ContentAnalyzer:
-		var p ContentAnalyzerParams
-		if err := json.Unmarshal(jsonBytes, &p); err != nil {
-			return fmt.Errorf("invalid params for %s: %w", toolName, err)
-		}
-		return p.Validate()
+// validateParams runs the params struct's own Validate method, falling back to
+// the struct tags when the type doesn't define one
+func validateParams(p interface{}) error {
+	if v, ok := p.(Validatable); ok {
+		return v.Validate()
+	}
+	return checkParams(p)
+}
 
-	case GoogleSearch:
-		var p GoogleSearchParams
-		if err := json.Unmarshal(jsonBytes, &p); err != nil {
-			return fmt.Errorf("invalid params for %s: %w", toolName, err)
-		}
-		return p.Validate()
+// ParseAndValidateParams takes raw params and validates them for a specific tool.
+// Aliases are resolved; use ParseAndValidateParamsWithWarnings to see alias and
+// deprecation warnings, or DecodeParams to also get the decoded params struct back.
+func ParseAndValidateParams(toolName string, params map[string]interface{}) error {
+	_, err := ParseAndValidateParamsWithWarnings(toolName, params)
+	return err
+}
 
-	default:
-		return fmt.Errorf("unknown tool: %s", toolName)
-	}
+// ParseAndValidateParamsWithWarnings is ParseAndValidateParams that also returns
+// non-fatal warnings, such as use of an alias or a deprecated tool
+func ParseAndValidateParamsWithWarnings(toolName string, params map[string]interface{}) ([]Warning, error) {
+	var warnings []Warning
+	_, err := DecodeParams(toolName, params, CollectWarnings(&warnings))
+	return warnings, err
 }
 
-// IsValidToolName checks if a string is a valid tool name
+// IsValidToolName checks if a string is a valid tool name or alias
 func IsValidToolName(name string) bool {
-	switch ToolName(name) {
-	case GenerateImageImagen, GenerateImageImagenFast, GenerateImageImagenUltra,
-		NanoBanana,
-		GenerateVideoVeo3, GenerateVideoVeo3Fast, GenerateVideoVeo3FastNoAudio, GenerateVideoVeo3NoAudio,
-		GenerateMusicLyria,
-		CombineVideos, TrimVideo, ImageAudioMerge, ExtractFrame, MergeImages, ImagesToVideo,
-		ContentAnalyzer, GoogleSearch:
-		return true
-	default:
-		return false
-	}
-}
\ No newline at end of file
+	_, _, exists := ResolveToolName(name)
+	return exists
+}
@@ -0,0 +1,95 @@
+package tools
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// TestRegistryConsistent fails on any drift CheckRegistry reports: metadata, endpoints,
+// signable fields, schemas, validator tags, defaults, lifecycle and model families
+func TestRegistryConsistent(t *testing.T) {
+	for _, issue := range CheckRegistry() {
+		t.Error(issue)
+	}
+}
+
+func TestRegisterAliasConflictLeavesRegistryUntouched(t *testing.T) {
+	const name ToolName = "test-alias-conflict"
+	err := Register(ToolDefinition{
+		Name:       name,
+		ParamsType: reflect.TypeOf(GoogleSearchParams{}),
+		Aliases:    []ToolName{"test-alias-conflict-ok", GenerateImageImagen},
+	})
+	if err == nil {
+		t.Fatal("registered an alias that collides with a registered tool")
+	}
+	if _, exists := LookupTool(name); exists {
+		t.Error("tool was registered despite the alias conflict")
+	}
+	if _, _, exists := ResolveToolName("test-alias-conflict-ok"); exists {
+		t.Error("alias before the conflicting one was recorded")
+	}
+	for _, registered := range RegisteredTools() {
+		if registered == name {
+			t.Error("tool was added to the registration order")
+		}
+	}
+}
+
+// TestRuntimeRegisterLeavesExportedMapsAlone registers tools while other goroutines
+// read the deprecated maps; run with -race to catch writes to them
+func TestRuntimeRegisterLeavesExportedMapsAlone(t *testing.T) {
+	builtins := len(Metadata)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		name := ToolName(fmt.Sprintf("test-runtime-%d", i))
+		t.Cleanup(func() { unregister(name) })
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			def, _ := LookupTool(GoogleSearch)
+			def.Name, def.Meta.Name = name, name
+			def.Signable = []string{"query"}
+			if err := Register(def); err != nil {
+				t.Error(err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			_ = Metadata[GenerateImageImagen]
+			_ = SignableFields[TrimVideo]
+		}()
+	}
+	wg.Wait()
+
+	if len(Metadata) != builtins {
+		t.Errorf("Metadata grew from %d to %d entries", builtins, len(Metadata))
+	}
+	if _, exists := GetToolMetadata("test-runtime-0"); !exists {
+		t.Error("runtime tool is missing from GetToolMetadata")
+	}
+	if fields := GetSignableFields("test-runtime-0"); len(fields) != 1 {
+		t.Errorf("GetSignableFields = %v, want [query]", fields)
+	}
+}
+
+// unregister removes a tool registered by a test, with its aliases
+func unregister(name ToolName) {
+	toolRegistry.mu.Lock()
+	defer toolRegistry.mu.Unlock()
+	delete(toolRegistry.defs, name)
+	for i, registered := range toolRegistry.order {
+		if registered == name {
+			toolRegistry.order = append(toolRegistry.order[:i:i], toolRegistry.order[i+1:]...)
+			break
+		}
+	}
+	for alias, target := range toolRegistry.aliases {
+		if target == name {
+			delete(toolRegistry.aliases, alias)
+		}
+	}
+}
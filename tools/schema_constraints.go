@@ -0,0 +1,269 @@
+package tools
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// requiredGroup is a set of JSON fields of which at least one must be present
+type requiredGroup struct {
+	fields    []string
+	exclusive bool // At most one may be present as well
+}
+
+// applyValidatorTagsToSchema translates the validate tags of every struct reachable
+// from root into JSON Schema keywords on the matching $defs entries, so the model
+// sees the same constraints the validator enforces:
+//
+//	required                       -> required
+//	required_without(_all)         -> anyOf of required fields (oneOf when excluded_with makes them exclusive)
+//	oneof / eq                     -> enum
+//	min / max / len / gt(e) / lt(e) -> minimum, maximum, minLength, maxLength, minItems, maxItems
+//	url                            -> format: uri
+//
+// Rules after "dive" apply to array items.
+func applyValidatorTagsToSchema(schema map[string]interface{}, root reflect.Type) {
+	defs, _ := schema["$defs"].(map[string]interface{})
+	for _, t := range schemaStructTypes(root) {
+		def, _ := defs[t.Name()].(map[string]interface{})
+		if def == nil {
+			continue
+		}
+		props, _ := def["properties"].(map[string]interface{})
+
+		var required []string
+		for _, field := range jsonFields(t) {
+			prop, _ := props[field.JSONName].(map[string]interface{})
+			if prop == nil {
+				continue
+			}
+
+			outer, inner, dives := splitDive(fieldValidateRules(field))
+			if ruleIn(outer, "required") {
+				required = append(required, field.JSONName)
+			}
+			applyRuleKeywords(prop, derefType(field.Type), outer)
+			if dives {
+				if items, ok := prop["items"].(map[string]interface{}); ok {
+					if _, isRef := items["$ref"]; !isRef {
+						applyRuleKeywords(items, derefType(elemType(field.Type)), inner)
+					}
+				}
+			}
+		}
+
+		if len(required) > 0 {
+			def["required"] = required
+		}
+		applyRequiredGroups(def, requiredGroups(t))
+	}
+}
+
+// applyRequiredGroups adds anyOf/oneOf keywords for the groups, wrapping them in allOf when there are several
+func applyRequiredGroups(def map[string]interface{}, groups []requiredGroup) {
+	var clauses []map[string]interface{}
+	for _, group := range groups {
+		options := make([]interface{}, len(group.fields))
+		for i, name := range group.fields {
+			options[i] = map[string]interface{}{"required": []string{name}}
+		}
+		keyword := "anyOf"
+		if group.exclusive {
+			keyword = "oneOf"
+		}
+		clauses = append(clauses, map[string]interface{}{keyword: options})
+	}
+
+	switch len(clauses) {
+	case 0:
+	case 1:
+		for keyword, options := range clauses[0] {
+			def[keyword] = options
+		}
+	default:
+		allOf := make([]interface{}, len(clauses))
+		for i, clause := range clauses {
+			allOf[i] = clause
+		}
+		def["allOf"] = allOf
+	}
+}
+
+// requiredGroups collects the "at least one of" groups declared with required_without(_all).
+// Rules that follow omitempty never fire for an empty field, so they don't form a group.
+func requiredGroups(t reflect.Type) []requiredGroup {
+	byGoName := make(map[string]string)
+	for _, field := range jsonFields(t) {
+		byGoName[field.GoName] = field.JSONName
+	}
+
+	excluded := make(map[[2]string]bool)
+	seen := make(map[string]bool)
+	var groups []requiredGroup
+	for _, field := range jsonFields(t) {
+		outer, _, _ := splitDive(fieldValidateRules(field))
+		for _, rule := range outer {
+			tag, param, _ := strings.Cut(rule, "=")
+			if tag == "omitempty" {
+				break
+			}
+			switch tag {
+			case "required_without", "required_without_all":
+				members := []string{field.JSONName}
+				for _, goName := range strings.Fields(param) {
+					if name, ok := byGoName[goName]; ok {
+						members = append(members, name)
+					}
+				}
+				sort.Strings(members)
+				if key := strings.Join(members, ","); !seen[key] {
+					seen[key] = true
+					groups = append(groups, requiredGroup{fields: members})
+				}
+			case "excluded_with":
+				for _, goName := range strings.Fields(param) {
+					if name, ok := byGoName[goName]; ok {
+						excluded[[2]string{field.JSONName, name}] = true
+						excluded[[2]string{name, field.JSONName}] = true
+					}
+				}
+			}
+		}
+	}
+
+	for i := range groups {
+		groups[i].exclusive = len(excluded) > 0 && allExclusive(groups[i].fields, excluded)
+	}
+	return groups
+}
+
+func allExclusive(fields []string, excluded map[[2]string]bool) bool {
+	for i := range fields {
+		for j := i + 1; j < len(fields); j++ {
+			if !excluded[[2]string{fields[i], fields[j]}] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// applyRuleKeywords sets the keywords for one level of rules on a property schema
+func applyRuleKeywords(prop map[string]interface{}, t reflect.Type, rules []string) {
+	kind := t.Kind()
+	for _, rule := range rules {
+		tag, param, _ := strings.Cut(rule, "=")
+		switch tag {
+		case "oneof":
+			var values []interface{}
+			for _, v := range strings.Fields(param) {
+				values = append(values, enumValue(kind, v))
+			}
+			prop["enum"] = values
+		case "eq":
+			prop["enum"] = []interface{}{enumValue(kind, param)}
+		case "min", "gte":
+			prop[boundKeyword(kind, "min")] = parseBound(param)
+		case "max", "lte":
+			prop[boundKeyword(kind, "max")] = parseBound(param)
+		case "gt", "lt":
+			if key := boundKeyword(kind, tag); key != "" {
+				prop[key] = parseBound(param)
+			}
+		case "len":
+			prop[boundKeyword(kind, "min")] = parseBound(param)
+			prop[boundKeyword(kind, "max")] = parseBound(param)
+		case "url":
+			prop["format"] = "uri"
+		case "required":
+			if kind == reflect.String && !ruleIn(rules, "min") && !ruleIn(rules, "len") {
+				prop["minLength"] = 1 // validator's required rejects ""
+			}
+		}
+	}
+}
+
+// boundKeyword names the JSON Schema keyword for a bound on a value of the given kind
+func boundKeyword(kind reflect.Kind, bound string) string {
+	switch kind {
+	case reflect.String:
+		return map[string]string{"min": "minLength", "max": "maxLength"}[bound]
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return map[string]string{"min": "minItems", "max": "maxItems"}[bound]
+	}
+	return map[string]string{"min": "minimum", "max": "maximum", "gt": "exclusiveMinimum", "lt": "exclusiveMaximum"}[bound]
+}
+
+// enumValue converts a oneof/eq parameter to the JSON type of the field
+func enumValue(kind reflect.Kind, v string) interface{} {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return n
+		}
+	case reflect.Float32, reflect.Float64:
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			return f
+		}
+	}
+	return v
+}
+
+// schemaStructTypes returns root and every struct type nested in it
+func schemaStructTypes(root reflect.Type) []reflect.Type {
+	var types []reflect.Type
+	seen := make(map[reflect.Type]bool)
+	var walk func(t reflect.Type)
+	walk = func(t reflect.Type) {
+		t = elemType(t)
+		if t.Kind() != reflect.Struct || seen[t] {
+			return
+		}
+		seen[t] = true
+		types = append(types, t)
+		for _, field := range jsonFields(t) {
+			walk(field.Type)
+		}
+	}
+	walk(root)
+	return types
+}
+
+// fieldValidateRules returns the field's validate rules, or nil when it has none
+func fieldValidateRules(field paramField) []string {
+	tag := field.Tag.Get("validate")
+	if tag == "" {
+		return nil
+	}
+	return strings.Split(tag, ",")
+}
+
+// splitDive separates the rules for a field from the rules for its elements
+func splitDive(rules []string) (outer, inner []string, dives bool) {
+	for i, rule := range rules {
+		if rule == "dive" {
+			return rules[:i], rules[i+1:], true
+		}
+	}
+	return rules, nil, false
+}
+
+func ruleIn(rules []string, tag string) bool {
+	for _, rule := range rules {
+		if name, _, _ := strings.Cut(rule, "="); name == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// derefType unwraps pointers
+func derefType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
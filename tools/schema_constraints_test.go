@@ -0,0 +1,378 @@
+package tools
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+// maxParityGroupFields caps the presence combinations probed per struct
+const maxParityGroupFields = 6
+
+// TestSchemaConstraintsMatchValidator probes each tool's JSON schema and its validator
+// tags with the same inputs and fails on every input one accepts and the other rejects.
+// The schema side is judged by an independent JSON Schema validator.
+//
+// Each constrained field is probed at and around its bounds and enum values, and the
+// fields that take part in required/required_without rules are probed in every
+// combination of present and absent. Zero values of omitempty fields are not probed:
+// the validator treats them as omitted, while the schema describes the values a
+// caller should actually send.
+func TestSchemaConstraintsMatchValidator(t *testing.T) {
+	for _, name := range RegisteredTools() {
+		name := name
+		t.Run(string(name), func(t *testing.T) {
+			def, _ := LookupTool(name)
+			schema, err := GetJSONSchema(name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defs, _ := schema["$defs"].(map[string]interface{})
+
+			for _, st := range schemaStructTypes(def.ParamsType) {
+				structSchema, _ := defs[st.Name()].(map[string]interface{})
+				if structSchema == nil {
+					continue
+				}
+				for _, issue := range fieldParity(t, name, st, structSchema) {
+					t.Error(issue)
+				}
+				for _, issue := range presenceParity(t, name, st, structSchema) {
+					t.Error(issue)
+				}
+			}
+		})
+	}
+}
+
+// fieldParity compares the verdicts for values of each constrained field
+func fieldParity(tb testing.TB, toolName ToolName, t reflect.Type, structSchema map[string]interface{}) []RegistryIssue {
+	props, _ := structSchema["properties"].(map[string]interface{})
+
+	var issues []RegistryIssue
+	for _, field := range jsonFields(t) {
+		prop, _ := props[field.JSONName].(map[string]interface{})
+		outer, _, _ := splitDive(fieldValidateRules(field))
+		rules := localRules(outer)
+		if prop == nil || len(rules) == 0 {
+			continue
+		}
+		if _, isRef := prop["$ref"]; isRef {
+			continue // struct values are probed through their own fields
+		}
+		valueType := derefType(field.Type)
+		propSchema := compileSchema(tb, withoutKeyword(prop, "items")) // element rules are probed separately
+
+		for _, probe := range fieldProbes(valueType, rules) {
+			if isZeroProbe(probe) && ruleIn(outer, "omitempty") {
+				continue
+			}
+			validatorOK := validate.Var(probe.Interface(), strings.Join(rules, ",")) == nil
+			schemaOK := propSchema.Validate(jsonValue(probe.Interface())) == nil
+			if validatorOK != schemaOK {
+				issues = append(issues, parityIssue(toolName, t, field.JSONName, jsonValue(probe.Interface()), validatorOK))
+			}
+		}
+	}
+	return issues
+}
+
+// presenceParity compares the verdicts for every combination of present and absent
+// fields among those with required or required_without rules
+func presenceParity(tb testing.TB, toolName ToolName, t reflect.Type, structSchema map[string]interface{}) []RegistryIssue {
+	var fields []paramField
+	for _, field := range jsonFields(t) {
+		outer, _, _ := splitDive(fieldValidateRules(field))
+		if ruleIn(outer, "required") || ruleIn(outer, "required_without") || ruleIn(outer, "required_without_all") {
+			fields = append(fields, field)
+		}
+	}
+	if len(fields) == 0 || len(fields) > maxParityGroupFields {
+		return nil
+	}
+
+	// Only the presence keywords take part; values are probed by fieldParity
+	presenceKeywords := make(map[string]interface{})
+	for _, keyword := range []string{"required", "anyOf", "oneOf", "allOf"} {
+		if value, ok := structSchema[keyword]; ok {
+			presenceKeywords[keyword] = value
+		}
+	}
+	presenceSchema := compileSchema(tb, presenceKeywords)
+
+	var issues []RegistryIssue
+	for mask := 0; mask < 1<<len(fields); mask++ {
+		obj := make(map[string]interface{})
+		var present []string
+		for i, field := range fields {
+			if mask&(1<<i) != 0 {
+				obj[field.JSONName] = presentValue(field.Type)
+				present = append(present, field.JSONName)
+			}
+		}
+
+		validatorOK := !hasPresenceErrors(t, obj, fields)
+		schemaOK := presenceSchema.Validate(jsonValue(obj)) == nil
+		if validatorOK != schemaOK {
+			issues = append(issues, parityIssue(toolName, t, "", map[string]interface{}{"present": present}, validatorOK))
+		}
+	}
+	return issues
+}
+
+// hasPresenceErrors decodes obj into t and reports whether the validator finds a
+// required-style failure on any of the given fields
+func hasPresenceErrors(t reflect.Type, obj map[string]interface{}, fields []paramField) bool {
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		return true
+	}
+	p := reflect.New(t)
+	if err := json.Unmarshal(raw, p.Interface()); err != nil {
+		return true
+	}
+
+	names := make(map[string]bool)
+	for _, field := range fields {
+		names[field.GoName] = true
+	}
+	fieldErrs, ok := validate.Struct(p.Interface()).(validator.ValidationErrors)
+	if !ok {
+		return false
+	}
+	for _, fe := range fieldErrs {
+		if !names[fe.StructField()] || strings.Count(fe.StructNamespace(), ".") != 1 {
+			continue
+		}
+		switch fe.Tag() {
+		case "required", "required_without", "required_without_all":
+			return true
+		}
+	}
+	return false
+}
+
+// fieldProbes builds values at and around each bound and enum member of the rules
+func fieldProbes(t reflect.Type, rules []string) []reflect.Value {
+	var probes []reflect.Value
+	add := func(v reflect.Value, ok bool) {
+		if ok {
+			probes = append(probes, v)
+		}
+	}
+
+	for _, rule := range rules {
+		tag, param, _ := strings.Cut(rule, "=")
+		switch tag {
+		case "min", "max", "len", "gt", "gte", "lt", "lte", "eq":
+			for _, delta := range []float64{-1, 0, 1} {
+				add(probeAround(t, param, delta))
+			}
+		case "oneof":
+			for _, member := range strings.Fields(param) {
+				add(probeAround(t, member, 0))
+			}
+			add(probeAround(t, strings.Fields(param)[0], 1)) // not a member unless members are adjacent
+		}
+	}
+	return probes
+}
+
+// probeAround builds a value of type t near a rule parameter. For strings and slices
+// the parameter is a length, except for string enums where it is the value itself.
+func probeAround(t reflect.Type, param string, delta float64) (reflect.Value, bool) {
+	v := reflect.New(t).Elem()
+	switch t.Kind() {
+	case reflect.String:
+		if n, err := strconv.Atoi(param); err == nil {
+			if n+int(delta) < 0 {
+				return v, false
+			}
+			v.SetString(strings.Repeat("a", n+int(delta)))
+			return v, true
+		}
+		if delta != 0 {
+			param += "-other"
+		}
+		v.SetString(param)
+		return v, true
+
+	case reflect.Slice:
+		n, err := strconv.Atoi(param)
+		if err != nil || n+int(delta) < 0 {
+			return v, false
+		}
+		v.Set(reflect.MakeSlice(t, n+int(delta), n+int(delta)))
+		return v, true
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(param, 10, 64)
+		if err != nil {
+			return v, false
+		}
+		v.SetInt(n + int64(delta))
+		return v, v.Int() == n+int64(delta)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(param, 10, 64)
+		if err != nil || (delta < 0 && n == 0) {
+			return v, false
+		}
+		v.SetUint(uint64(int64(n) + int64(delta)))
+		return v, true
+
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(param, 64)
+		if err != nil {
+			return v, false
+		}
+		v.SetFloat(f + delta*0.05)
+		return v, true
+	}
+	return v, false
+}
+
+// presentValue is a non-zero JSON value for a field, used to mark it present
+func presentValue(t reflect.Type) interface{} {
+	t = derefType(t)
+	switch t.Kind() {
+	case reflect.String:
+		return "x"
+	case reflect.Bool:
+		return true
+	case reflect.Slice, reflect.Array:
+		return []interface{}{presentValue(t.Elem())}
+	case reflect.Map:
+		return map[string]interface{}{}
+	case reflect.Struct:
+		// A zero struct counts as missing, so set its required fields (or its first field)
+		obj := make(map[string]interface{})
+		fields := jsonFields(t)
+		for _, field := range fields {
+			if outer, _, _ := splitDive(fieldValidateRules(field)); ruleIn(outer, "required") {
+				obj[field.JSONName] = presentValue(field.Type)
+			}
+		}
+		if len(obj) == 0 && len(fields) > 0 {
+			obj[fields[0].JSONName] = presentValue(fields[0].Type)
+		}
+		return obj
+	}
+	return 1
+}
+
+func isZeroProbe(v reflect.Value) bool {
+	if v.Kind() == reflect.Slice {
+		return v.Len() == 0
+	}
+	return v.IsZero()
+}
+
+// jsonValue round-trips a Go value through JSON so schemas see what a caller would send
+func jsonValue(v interface{}) interface{} {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	var out interface{}
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return nil
+	}
+	return out
+}
+
+func withoutKeyword(schema map[string]interface{}, keyword string) map[string]interface{} {
+	out := make(map[string]interface{}, len(schema))
+	for key, value := range schema {
+		if key != keyword {
+			out[key] = value
+		}
+	}
+	return out
+}
+
+func parityIssue(toolName ToolName, t reflect.Type, field string, value interface{}, validatorOK bool) RegistryIssue {
+	raw, _ := json.Marshal(value)
+	if s, ok := value.(string); ok && len(s) > 40 {
+		raw = []byte(fmt.Sprintf("a %d-character string", utf8.RuneCountInString(s)))
+	}
+	verdict := "validator accepts %s but the schema rejects it"
+	if !validatorOK {
+		verdict = "schema accepts %s but the validator rejects it"
+	}
+	if field == "" {
+		field = t.Name()
+	} else {
+		field = t.Name() + "." + field
+	}
+	return RegistryIssue{Tool: toolName, Kind: IssueSchema, Field: field, Message: fmt.Sprintf(verdict, raw)}
+}
+
+// compileSchema compiles a schema fragment as draft 2020-12, the draft GetJSONSchema emits
+func compileSchema(tb testing.TB, schema map[string]interface{}) *jsonschema.Schema {
+	tb.Helper()
+	raw, err := json.Marshal(schema)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	compiler := jsonschema.NewCompiler()
+	compiler.Draft = jsonschema.Draft2020
+	if err := compiler.AddResource("mem://schema.json", bytes.NewReader(raw)); err != nil {
+		tb.Fatal(err)
+	}
+	compiled, err := compiler.Compile("mem://schema.json")
+	if err != nil {
+		tb.Fatalf("compiling %s: %v", raw, err)
+	}
+	return compiled
+}
+
+// TestMimeTypeSchemaMatchesValidator probes the published mime_type keyword with the
+// spellings the media constraint check accepts and a few it rejects
+func TestMimeTypeSchemaMatchesValidator(t *testing.T) {
+	for _, name := range RegisteredTools() {
+		meta, _ := GetToolMetadata(name)
+		if meta.InputMedia.IsZero() {
+			continue
+		}
+		schema, err := GetJSONSchema(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defs, _ := schema["$defs"].(map[string]interface{})
+		for def, kind := range map[string]OutputType{"InputImage": OutputTypeImage, "InputVideo": OutputTypeVideo, "InputAudio": OutputTypeAudio} {
+			limits := meta.InputMedia.limitsFor(kind)
+			prop, _ := lookupProp(defs[def], "mime_type")
+			if limits == nil || prop == nil {
+				continue
+			}
+			compiled := compileSchema(t, prop)
+
+			probes := []string{"image/gif", "video/x-msvideo", "text/plain", limits.MimeTypes[0] + "x", "x" + limits.MimeTypes[0]}
+			for _, mimeType := range limits.MimeTypes {
+				probes = append(probes, mimeType, strings.ToUpper(mimeType), mimeType+"; codecs=avc1", " "+mimeType+" ")
+			}
+			for _, probe := range probes {
+				validatorOK := containsFold(limits.MimeTypes, probe)
+				if schemaOK := compiled.Validate(probe) == nil; schemaOK != validatorOK {
+					t.Errorf("%s %s.mime_type %q: schema accepts = %v, check accepts = %v", name, def, probe, schemaOK, validatorOK)
+				}
+			}
+		}
+	}
+}
+
+func lookupProp(def interface{}, field string) (map[string]interface{}, bool) {
+	obj, _ := def.(map[string]interface{})
+	props, _ := obj["properties"].(map[string]interface{})
+	prop, ok := props[field].(map[string]interface{})
+	return prop, ok
+}
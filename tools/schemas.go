@@ -6,60 +6,20 @@ import (
 	"github.com/invopop/jsonschema"
 )
 
-// GetJSONSchema returns the JSON schema for a tool's parameters
-// This is used by Chat AI to configure Gemini's function declarations
+// GetJSONSchema returns the JSON schema for a tool's parameters.
+// Nested types are left as $ref/$defs; use GetGeminiDeclaration, GetOpenAITool or
+// GetAnthropicTool for provider-ready function declarations.
 func GetJSONSchema(toolName ToolName) (map[string]interface{}, error) {
 	reflector := &jsonschema.Reflector{
 		RequiredFromJSONSchemaTags: true,
 		AllowAdditionalProperties:  false,
 	}
 
-	var schema *jsonschema.Schema
-
-	switch toolName {
-	// Media Generation Tools
-	case GenerateImageImagen:
-		schema = reflector.Reflect(&GenerateImageImagenParams{})
-	case GenerateImageImagenFast:
-		schema = reflector.Reflect(&GenerateImageImagenFastParams{})
-	case GenerateImageImagenUltra:
-		schema = reflector.Reflect(&GenerateImageImagenUltraParams{})
-	case NanoBanana:
-		schema = reflector.Reflect(&NanoBananaParams{})
-	case GenerateVideoVeo3:
-		schema = reflector.Reflect(&GenerateVideoVeo3Params{})
-	case GenerateVideoVeo3Fast:
-		schema = reflector.Reflect(&GenerateVideoVeo3FastParams{})
-	case GenerateVideoVeo3FastNoAudio:
-		schema = reflector.Reflect(&GenerateVideoVeo3FastNoAudioParams{})
-	case GenerateVideoVeo3NoAudio:
-		schema = reflector.Reflect(&GenerateVideoVeo3NoAudioParams{})
-	case GenerateMusicLyria:
-		schema = reflector.Reflect(&GenerateMusicLyriaParams{})
-
-	// Video Processing Tools
-	case CombineVideos:
-		schema = reflector.Reflect(&CombineVideosParams{})
-	case TrimVideo:
-		schema = reflector.Reflect(&TrimVideoParams{})
-	case ImageAudioMerge:
-		schema = reflector.Reflect(&ImageAudioMergeParams{})
-	case ExtractFrame:
-		schema = reflector.Reflect(&ExtractFrameParams{})
-	case MergeImages:
-		schema = reflector.Reflect(&MergeImagesParams{})
-	case ImagesToVideo:
-		schema = reflector.Reflect(&ImagesToVideoParams{})
-
-	// Content Analysis Tools
-	case ContentAnalyzer:
-		schema = reflector.Reflect(&ContentAnalyzerParams{})
-	case GoogleSearch:
-		schema = reflector.Reflect(&GoogleSearchParams{})
-
-	default:
+	def, exists := LookupTool(toolName)
+	if !exists {
 		return nil, fmt.Errorf("unknown tool: %s", toolName)
 	}
+	schema := reflector.ReflectFromType(def.ParamsType)
 
 	// Convert to map for easier manipulation
 	schemaBytes, err := json.Marshal(schema)
@@ -72,6 +32,10 @@ func GetJSONSchema(toolName ToolName) (map[string]interface{}, error) {
 		return nil, fmt.Errorf("failed to unmarshal schema: %w", err)
 	}
 
+	applyValidatorTagsToSchema(schemaMap, def.ParamsType)
+	applyDefaultsToSchema(schemaMap, def.ParamsType)
+	applyMediaConstraintsToSchema(schemaMap, def.Meta.InputMedia)
+
 	// Remove $schema and $id fields that Gemini doesn't need
 	delete(schemaMap, "$schema")
 	delete(schemaMap, "$id")
@@ -79,21 +43,15 @@ func GetJSONSchema(toolName ToolName) (map[string]interface{}, error) {
 	return schemaMap, nil
 }
 
-// GetAllSchemas returns JSON schemas for all tools
-// Useful for Chat AI to cache at startup
-func GetAllSchemas() map[ToolName]map[string]interface{} {
+// GetAllSchemas returns JSON schemas for all tools, or those passing every filter
+// (e.g. AvailableTo(caller)). Useful for Chat AI to cache at startup
+func GetAllSchemas(filters ...ToolFilter) map[ToolName]map[string]interface{} {
 	schemas := make(map[ToolName]map[string]interface{})
 
-	tools := []ToolName{
-		GenerateImageImagen, GenerateImageImagenFast, GenerateImageImagenUltra,
-		NanoBanana,
-		GenerateVideoVeo3, GenerateVideoVeo3Fast, GenerateVideoVeo3FastNoAudio, GenerateVideoVeo3NoAudio,
-		GenerateMusicLyria,
-		CombineVideos, TrimVideo, ImageAudioMerge, ExtractFrame, MergeImages, ImagesToVideo,
-		ContentAnalyzer, GoogleSearch,
-	}
-
-	for _, tool := range tools {
+	for _, tool := range RegisteredTools() {
+		if !passes(tool, filters) {
+			continue
+		}
 		if schema, err := GetJSONSchema(tool); err == nil {
 			schemas[tool] = schema
 		}
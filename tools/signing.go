@@ -1,24 +1,23 @@
 package tools
 
-// SignableFields defines which fields need URL signing for each tool
-// This keeps the signing logic centralized in contracts
-var SignableFields = map[ToolName][]string{
-	// Video processing tools that need signed URLs
-	CombineVideos:   {"video_urls"},
-	TrimVideo:       {"video_url"},
-	ImageAudioMerge: {"image_url", "audio_url"},
-	ExtractFrame:    {"video_url"},
-	MergeImages:     {"images"},
-	ImagesToVideo:   {"image_urls", "audio_url"},
-}
+// SignableFields defines which fields need URL signing for each built-in tool
+// This keeps the signing logic centralized in contracts; entries come from each
+// tool's ToolDefinition.Signable.
+//
+// Deprecated: SignableFields is filled once at package initialization and does not
+// see tools registered at runtime. Use GetSignableFields or NeedsSignedURLs.
+var SignableFields = map[ToolName][]string{}
 
 // NeedsSignedURLs checks if a tool requires URL signing
 func NeedsSignedURLs(toolName ToolName) bool {
-	_, exists := SignableFields[toolName]
-	return exists
+	def, exists := LookupTool(toolName)
+	return exists && len(def.Signable) > 0
 }
 
 // GetSignableFields returns the fields that need signing for a tool
 func GetSignableFields(toolName ToolName) []string {
-	return SignableFields[toolName]
+	if def, exists := LookupTool(toolName); exists {
+		return def.Signable
+	}
+	return nil
 }
\ No newline at end of file
@@ -0,0 +1,86 @@
+package tools
+
+import "strings"
+
+// closestMatch returns the option nearest to s by edit distance, ignoring case.
+// Returns false when even the nearest option shares too little with s to be a
+// plausible typo, such as a value from a different field.
+func closestMatch(s string, options []string) (string, bool) {
+	needle := strings.ToLower(s)
+	best, bestDistance := "", -1
+	for _, option := range options {
+		d := editDistance(needle, strings.ToLower(option))
+		if bestDistance == -1 || d < bestDistance {
+			best, bestDistance = option, d
+		}
+	}
+	if bestDistance == -1 {
+		return "", false
+	}
+
+	// Up to half the option's characters may differ, at least two, but never all of them
+	length := len([]rune(best))
+	limit := length / 2
+	if limit < 2 {
+		limit = 2
+	}
+	return best, bestDistance <= limit && bestDistance < length
+}
+
+// editDistance is the optimal string alignment distance between a and b:
+// insertions, deletions, substitutions and swaps of adjacent characters
+// ("fdae" for "fade") each count as one edit
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	d := make([][]int, len(ra)+1)
+	for i := range d {
+		d[i] = make([]int, len(rb)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			d[i][j] = minInt(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				d[i][j] = minInt(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(ra)][len(rb)]
+}
+
+func minInt(values ...int) int {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
+
+// canonicalToken reduces a value to lowercase letters and digits so that
+// "16x9", "16:9" and "16/9" or "aspectRatio" and "aspect_ratio" compare equal
+func canonicalToken(s string) string {
+	var b strings.Builder
+	lower := strings.ToLower(strings.TrimSpace(s))
+	for i, r := range lower {
+		switch {
+		case r == 'x' && i > 0 && i < len(lower)-1 && isDigit(lower[i-1]) && isDigit(lower[i+1]):
+			// "16x9": a separator between digits, not a letter
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '.':
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,126 @@
+package tools
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/metaphi-labs/latent-contracts/errors"
+	"github.com/metaphi-labs/latent-contracts/types"
+)
+
+// durationTolerance absorbs rounding when end_time and duration are both given
+const durationTolerance = 0.01
+
+// framePositions are the named positions accepted by extract-frame
+var framePositions = map[string]bool{"first": true, "last": true, "middle": true}
+
+// Range resolves the trim window to seconds.
+// fps converts frame-based timecodes; pass 0 to use types.DefaultFrameRate.
+func (p TrimVideoParams) Range(fps float64) (start float64, end float64, err error) {
+	if p.StartTime != "" {
+		tc, err := types.ParseTimecode(p.StartTime)
+		if err != nil {
+			return 0, 0, err
+		}
+		start = tc.Seconds(fps)
+	}
+
+	switch {
+	case p.EndTime != "":
+		tc, err := types.ParseTimecode(p.EndTime)
+		if err != nil {
+			return 0, 0, err
+		}
+		end = tc.Seconds(fps)
+	case p.Duration != nil:
+		end = start + *p.Duration
+	default:
+		return 0, 0, fmt.Errorf("either end_time or duration is required")
+	}
+	return start, end, nil
+}
+
+// timingDetails checks that the trim timecodes parse and describe a consistent window
+func (p TrimVideoParams) timingDetails() []errors.ValidationDetail {
+	var details []errors.ValidationDetail
+
+	var start, end types.Timecode
+	startOK, endOK := true, p.EndTime != ""
+	if p.StartTime != "" {
+		var detail *errors.ValidationDetail
+		start, detail = parseTimecodeParam("start_time", p.StartTime)
+		if detail != nil {
+			details = append(details, *detail)
+			startOK = false
+		}
+	}
+	if p.EndTime != "" {
+		var detail *errors.ValidationDetail
+		end, detail = parseTimecodeParam("end_time", p.EndTime)
+		if detail != nil {
+			details = append(details, *detail)
+			endOK = false
+		}
+	}
+	if !startOK || !endOK {
+		return details
+	}
+
+	startSec, endSec := start.Seconds(0), end.Seconds(0)
+	if endSec <= startSec {
+		return append(details, errors.ValidationDetail{
+			Field:    "end_time",
+			Provided: p.EndTime,
+			Expected: map[string]interface{}{"after": start.String()},
+			Reason:   fmt.Sprintf("end_time %s must be after start_time %s", end, start),
+			Code:     errors.MEDIA_INVALID_DURATION,
+		})
+	}
+
+	if p.Duration != nil && math.Abs((endSec-startSec)-*p.Duration) > durationTolerance {
+		details = append(details, errors.ValidationDetail{
+			Field:    "duration",
+			Provided: *p.Duration,
+			Expected: endSec - startSec,
+			Reason: fmt.Sprintf("duration %gs does not match end_time - start_time (%gs)",
+				*p.Duration, endSec-startSec),
+			Code: errors.MEDIA_INVALID_DURATION,
+		})
+	}
+	return details
+}
+
+// timingDetails checks that extract-frame timestamps parse
+func (p ExtractFrameParams) timingDetails() []errors.ValidationDetail {
+	var details []errors.ValidationDetail
+	if p.Timestamp != "" {
+		if _, detail := parseTimecodeParam("timestamp", p.Timestamp); detail != nil {
+			details = append(details, *detail)
+		}
+	}
+	for i, position := range p.Positions {
+		if framePositions[position] {
+			continue
+		}
+		if _, detail := parseTimecodeParam(fmt.Sprintf("positions[%d]", i), position); detail != nil {
+			detail.Reason = fmt.Sprintf("position '%s' must be first, last, middle or a timecode", position)
+			details = append(details, *detail)
+		}
+	}
+	return details
+}
+
+// parseTimecodeParam parses a timecode param, returning a detail when it is malformed
+func parseTimecodeParam(field, value string) (types.Timecode, *errors.ValidationDetail) {
+	tc, err := types.ParseTimecode(value)
+	if err != nil {
+		return types.Timecode{}, &errors.ValidationDetail{
+			Field:    field,
+			Provided: value,
+			Expected: types.TimecodeFormats,
+			Reason:   fmt.Sprintf("Parameter '%s' is not a valid timecode", field),
+			Code:     errors.MEDIA_INVALID_DURATION,
+		}
+	}
+	return tc, nil
+}
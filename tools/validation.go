@@ -2,141 +2,409 @@ package tools
 
 import (
 	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
 	"github.com/go-playground/validator/v10"
+	"github.com/metaphi-labs/latent-contracts/errors"
 )
 
-var validate = validator.New()
+var validate = newValidator()
 
-// Validate implementations for Media Generation tools
+// newValidator reports field errors by their JSON names so namespaces double as JSON paths
+func newValidator() *validator.Validate {
+	v := validator.New(validator.WithRequiredStructEnabled())
+	v.RegisterTagNameFunc(func(fld reflect.StructField) string {
+		name, _ := parseJSONTag(fld.Tag.Get("json"))
+		if name == "-" {
+			return ""
+		}
+		return name
+	})
+	return v
+}
 
-func (p GenerateImageImagenParams) Validate() error {
-	if err := validate.Struct(p); err != nil {
-		return fmt.Errorf("validation failed: %w", err)
+// ParamErrors collects every validation failure found in a params struct
+type ParamErrors []errors.ValidationDetail
+
+// Error implements the error interface
+func (e ParamErrors) Error() string {
+	reasons := make([]string, len(e))
+	for i, detail := range e {
+		reasons[i] = detail.Reason
 	}
-	return nil
+	return "validation failed: " + strings.Join(reasons, "; ")
 }
 
-func (p GenerateImageImagenFastParams) Validate() error {
+// checkParams validates the struct tags of p and merges the failures with the
+// details found by the struct's own checks. Returns nil when p is valid.
+func checkParams(p interface{}, custom ...errors.ValidationDetail) error {
+	details := ParamErrors(custom)
+
+	// Fields already reported by a custom "either X or Y" check
+	covered := make(map[string]bool)
+	for _, detail := range custom {
+		if fields, ok := detail.Expected.([]string); ok && detail.Code == errors.VAL_DEPENDENCY_MISSING {
+			for _, field := range fields {
+				covered[field] = true
+			}
+		}
+	}
+
 	if err := validate.Struct(p); err != nil {
-		return fmt.Errorf("validation failed: %w", err)
+		fieldErrs, ok := err.(validator.ValidationErrors)
+		if !ok {
+			return fmt.Errorf("validation failed: %w", err)
+		}
+		for _, detail := range fieldErrorDetails(reflect.TypeOf(p), fieldErrs) {
+			if detail.Code == errors.VAL_DEPENDENCY_MISSING && covered[detail.Field] {
+				continue
+			}
+			details = append(details, detail)
+		}
 	}
-	return nil
+
+	if len(details) == 0 {
+		return nil
+	}
+	return details
 }
 
-func (p GenerateImageImagenUltraParams) Validate() error {
-	if err := validate.Struct(p); err != nil {
-		return fmt.Errorf("validation failed: %w", err)
+// eitherRequired reports that at least one of the given JSON fields must be set
+func eitherRequired(fields ...string) errors.ValidationDetail {
+	quoted := make([]string, len(fields))
+	for i, field := range fields {
+		quoted[i] = "'" + field + "'"
+	}
+	return errors.ValidationDetail{
+		Field:    fields[0],
+		Expected: fields,
+		Reason:   fmt.Sprintf("Either %s is required", strings.Join(quoted, " or ")),
+		Code:     errors.VAL_DEPENDENCY_MISSING,
 	}
-	return nil
+}
+
+// Validate implementations for Media Generation tools
+
+func (p GenerateImageImagenParams) Validate() error {
+	return checkParams(p)
+}
+
+func (p GenerateImageImagenFastParams) Validate() error {
+	return checkParams(p)
+}
+
+func (p GenerateImageImagenUltraParams) Validate() error {
+	return checkParams(p)
 }
 
 func (p GenerateVideoVeo3Params) Validate() error {
 	// Custom validation for required_without
+	var details []errors.ValidationDetail
 	if p.Prompt == "" && p.Image == nil {
-		return fmt.Errorf("either prompt or image is required")
+		details = append(details, eitherRequired("prompt", "image"))
 	}
-	if err := validate.Struct(p); err != nil {
-		return fmt.Errorf("validation failed: %w", err)
-	}
-	return nil
+	return checkParams(p, details...)
 }
 
 func (p GenerateVideoVeo3FastParams) Validate() error {
-	if err := validate.Struct(p); err != nil {
-		return fmt.Errorf("validation failed: %w", err)
-	}
-	return nil
+	return checkParams(p)
 }
 
 func (p GenerateVideoVeo3FastNoAudioParams) Validate() error {
-	if err := validate.Struct(p); err != nil {
-		return fmt.Errorf("validation failed: %w", err)
-	}
-	return nil
+	return checkParams(p)
 }
 
 func (p GenerateVideoVeo3NoAudioParams) Validate() error {
 	// Same as GenerateVideoVeo3Params
+	var details []errors.ValidationDetail
 	if p.Prompt == "" && p.Image == nil {
-		return fmt.Errorf("either prompt or image is required")
-	}
-	if err := validate.Struct(p); err != nil {
-		return fmt.Errorf("validation failed: %w", err)
+		details = append(details, eitherRequired("prompt", "image"))
 	}
-	return nil
+	return checkParams(p, details...)
 }
 
 func (p GenerateMusicLyriaParams) Validate() error {
-	if err := validate.Struct(p); err != nil {
-		return fmt.Errorf("validation failed: %w", err)
-	}
-	return nil
+	return checkParams(p)
 }
 
 // Validate implementations for Video Processing tools
 
 func (p CombineVideosParams) Validate() error {
-	if err := validate.Struct(p); err != nil {
-		return fmt.Errorf("validation failed: %w", err)
-	}
-	return nil
+	return checkParams(p)
 }
 
 func (p TrimVideoParams) Validate() error {
 	// Custom validation for required_without
+	var details []errors.ValidationDetail
 	if p.EndTime == "" && p.Duration == nil {
-		return fmt.Errorf("either end_time or duration is required")
-	}
-	if err := validate.Struct(p); err != nil {
-		return fmt.Errorf("validation failed: %w", err)
+		details = append(details, eitherRequired("end_time", "duration"))
 	}
-	return nil
+	details = append(details, p.timingDetails()...)
+	return checkParams(p, details...)
 }
 
 func (p ImageAudioMergeParams) Validate() error {
-	if err := validate.Struct(p); err != nil {
-		return fmt.Errorf("validation failed: %w", err)
-	}
-	return nil
+	return checkParams(p)
 }
 
 func (p ExtractFrameParams) Validate() error {
-	// Custom validation for required_without
-	if p.Position == "" && p.Timestamp == "" {
-		return fmt.Errorf("either position or timestamp is required")
+	// Custom validation for required_without_all
+	var details []errors.ValidationDetail
+	if p.Position == "" && p.Timestamp == "" && len(p.Positions) == 0 {
+		details = append(details, eitherRequired("position", "timestamp", "positions"))
 	}
-	if err := validate.Struct(p); err != nil {
-		return fmt.Errorf("validation failed: %w", err)
-	}
-	return nil
+	details = append(details, p.timingDetails()...)
+	return checkParams(p, details...)
 }
 
 func (p MergeImagesParams) Validate() error {
-	if err := validate.Struct(p); err != nil {
-		return fmt.Errorf("validation failed: %w", err)
-	}
-	return nil
+	return checkParams(p)
 }
 
 func (p ImagesToVideoParams) Validate() error {
-	if err := validate.Struct(p); err != nil {
-		return fmt.Errorf("validation failed: %w", err)
-	}
-	return nil
+	return checkParams(p)
 }
 
 // Validate implementations for Content Analysis tools
 
 func (p ContentAnalyzerParams) Validate() error {
-	if err := validate.Struct(p); err != nil {
-		return fmt.Errorf("validation failed: %w", err)
-	}
-	return nil
+	return checkParams(p)
 }
 
 func (p GoogleSearchParams) Validate() error {
-	if err := validate.Struct(p); err != nil {
-		return fmt.Errorf("validation failed: %w", err)
+	return checkParams(p)
+}
+
+// fieldErrorDetails converts validator field errors into ValidationDetails.
+// root is the validated struct type; it is used to look up the full tag of each
+// field so bounds can be reported alongside the failing rule.
+func fieldErrorDetails(root reflect.Type, fieldErrs validator.ValidationErrors) []errors.ValidationDetail {
+	details := make([]errors.ValidationDetail, 0, len(fieldErrs))
+	for _, fe := range fieldErrs {
+		details = append(details, fieldErrorDetail(root, fe))
+	}
+	return details
+}
+
+func fieldErrorDetail(root reflect.Type, fe validator.FieldError) errors.ValidationDetail {
+	path := fe.Namespace()
+	if idx := strings.Index(path, "."); idx != -1 {
+		path = path[idx+1:] // drop the root struct name
+	}
+	name := fe.Field()
+	param := fe.Param()
+	rules := fieldRules(root, fe.StructNamespace())
+
+	detail := errors.ValidationDetail{
+		Field:    path,
+		Provided: providedValue(fe),
+	}
+
+	switch fe.Tag() {
+	case "required":
+		detail.Code = errors.VAL_MISSING_PARAMETER
+		detail.Provided = nil
+		detail.Reason = fmt.Sprintf("Required parameter '%s' is missing", name)
+
+	case "min", "max", "gt", "gte", "lt", "lte":
+		detail.Expected = boundsExpectation(fe.Kind(), rules)
+		detail.Code, detail.Reason = boundsFailure(fe.Kind(), fe.Tag(), name, param)
+
+	case "len":
+		detail.Code = errors.VAL_INVALID_PARAMETER
+		detail.Expected = map[string]interface{}{lengthKey(fe.Kind(), "length"): parseBound(param)}
+		detail.Reason = fmt.Sprintf("Parameter '%s' must have length %s", name, param)
+
+	case "oneof":
+		detail.Code = errors.VAL_INVALID_ENUM
+		detail.Expected = strings.Fields(param)
+		detail.Reason = fmt.Sprintf("Parameter '%s' must be one of: %s", name, param)
+		if provided, ok := detail.Provided.(string); ok {
+			if suggestion, close := closestMatch(provided, strings.Fields(param)); close {
+				detail.Suggestion = suggestion
+				detail.Reason += fmt.Sprintf(" (did you mean '%s'?)", suggestion)
+			}
+		}
+
+	case "eq":
+		detail.Code = errors.VAL_INVALID_PARAMETER
+		detail.Expected = param
+		detail.Reason = fmt.Sprintf("Parameter '%s' must be exactly %s", name, param)
+
+	case "url":
+		detail.Code = errors.VAL_INVALID_URL
+		detail.Reason = fmt.Sprintf("Parameter '%s' must be a valid URL", name)
+
+	case "required_without", "required_without_all":
+		others := jsonNames(root, fe.StructNamespace(), strings.Fields(param))
+		detail.Code = errors.VAL_DEPENDENCY_MISSING
+		detail.Provided = nil
+		detail.Expected = append([]string{name}, others...)
+		detail.Reason = fmt.Sprintf("Parameter '%s' is required when '%s' is not provided",
+			name, strings.Join(others, "' or '"))
+
+	default:
+		detail.Code = errors.VAL_INVALID_PARAMETER
+		detail.Reason = fmt.Sprintf("Parameter '%s' is invalid: %s validation failed", name, fe.Tag())
+	}
+
+	return detail
+}
+
+// providedValue dereferences pointers so details carry the actual value
+func providedValue(fe validator.FieldError) interface{} {
+	v := reflect.ValueOf(fe.Value())
+	for v.IsValid() && v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return nil
+	}
+	return v.Interface()
+}
+
+// boundsFailure maps a failed bound to an error code and reason based on the field kind
+func boundsFailure(kind reflect.Kind, tag, name, param string) (errors.ErrorCode, string) {
+	lower := tag == "min" || tag == "gt" || tag == "gte"
+	switch kind {
+	case reflect.String:
+		if lower {
+			return errors.VAL_STRING_TOO_SHORT, fmt.Sprintf("Parameter '%s' must be at least %s characters long", name, param)
+		}
+		return errors.VAL_STRING_TOO_LONG, fmt.Sprintf("Parameter '%s' must be at most %s characters long", name, param)
+	case reflect.Slice, reflect.Array, reflect.Map:
+		if lower {
+			return errors.VAL_ARRAY_TOO_SHORT, fmt.Sprintf("Parameter '%s' must have at least %s items", name, param)
+		}
+		return errors.VAL_ARRAY_TOO_LONG, fmt.Sprintf("Parameter '%s' must have at most %s items", name, param)
+	}
+	if lower {
+		return errors.VAL_OUT_OF_RANGE, fmt.Sprintf("Parameter '%s' must be at least %s", name, param)
+	}
+	return errors.VAL_OUT_OF_RANGE, fmt.Sprintf("Parameter '%s' must be at most %s", name, param)
+}
+
+// boundsExpectation collects every bound declared on a field, e.g. {"minimum": 5, "maximum": 8}
+func boundsExpectation(kind reflect.Kind, rules []string) map[string]interface{} {
+	bounds := make(map[string]interface{})
+	for _, rule := range rules {
+		tag, param, found := strings.Cut(rule, "=")
+		if !found {
+			continue
+		}
+		switch tag {
+		case "min", "gte", "gt":
+			bounds[lengthKey(kind, "minimum")] = parseBound(param)
+		case "max", "lte", "lt":
+			bounds[lengthKey(kind, "maximum")] = parseBound(param)
+		}
+	}
+	if len(bounds) == 0 {
+		return nil
+	}
+	return bounds
+}
+
+// lengthKey names a bound the way JSON Schema does for the given kind
+func lengthKey(kind reflect.Kind, bound string) string {
+	switch kind {
+	case reflect.String:
+		return map[string]string{"minimum": "min_length", "maximum": "max_length", "length": "length"}[bound]
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return map[string]string{"minimum": "min_items", "maximum": "max_items", "length": "items"}[bound]
+	}
+	return bound
+}
+
+// parseBound returns a numeric bound as a number when possible
+func parseBound(param string) interface{} {
+	if n, err := strconv.ParseInt(param, 10, 64); err == nil {
+		return n
+	}
+	if f, err := strconv.ParseFloat(param, 64); err == nil {
+		return f
+	}
+	return param
+}
+
+// fieldRules returns the validate rules that apply to the field at a struct namespace
+// such as "CombineVideosParams.Videos[2].StorageURL". For slice elements only the
+// rules after "dive" apply.
+func fieldRules(root reflect.Type, structNamespace string) []string {
+	field, indexed, ok := lookupStructField(root, structNamespace)
+	if !ok {
+		return nil
+	}
+	rules := strings.Split(field.Tag.Get("validate"), ",")
+	for i, rule := range rules {
+		if rule == "dive" {
+			if indexed {
+				return rules[i+1:]
+			}
+			return rules[:i]
+		}
 	}
-	return nil
-}
\ No newline at end of file
+	return rules
+}
+
+// jsonNames maps Go field names of the struct containing the namespace's last field to JSON names
+func jsonNames(root reflect.Type, structNamespace string, goNames []string) []string {
+	parent := root
+	if idx := strings.LastIndex(structNamespace, "."); idx != -1 {
+		if field, _, ok := lookupStructField(root, structNamespace[:idx]); ok {
+			parent = elemType(field.Type)
+		}
+	}
+
+	names := make([]string, len(goNames))
+	for i, goName := range goNames {
+		names[i] = toSnakeCase(goName)
+		if parent == nil {
+			continue
+		}
+		if f, ok := elemType(parent).FieldByName(goName); ok {
+			if jsonName, _ := parseJSONTag(f.Tag.Get("json")); jsonName != "" && jsonName != "-" {
+				names[i] = jsonName
+			}
+		}
+	}
+	return names
+}
+
+// lookupStructField walks a validator struct namespace from root.
+// indexed reports whether the last segment addressed a slice element.
+func lookupStructField(root reflect.Type, structNamespace string) (field reflect.StructField, indexed bool, ok bool) {
+	if root == nil {
+		return reflect.StructField{}, false, false
+	}
+	segments := strings.Split(structNamespace, ".")
+	if len(segments) < 2 {
+		return reflect.StructField{}, false, false
+	}
+
+	t := root
+	for _, segment := range segments[1:] {
+		name := segment
+		indexed = false
+		if idx := strings.Index(segment, "["); idx != -1 {
+			name = segment[:idx]
+			indexed = true
+		}
+
+		t = elemType(t)
+		if t.Kind() != reflect.Struct {
+			return reflect.StructField{}, false, false
+		}
+		field, ok = t.FieldByName(name)
+		if !ok {
+			return reflect.StructField{}, false, false
+		}
+		t = field.Type
+	}
+	return field, indexed, true
+}
@@ -6,11 +6,11 @@ import "github.com/metaphi-labs/latent-contracts/types"
 
 // CombineVideosParams for combine-videos tool
 type CombineVideosParams struct {
-	Videos        []types.InputVideo `json:"videos" validate:"required,min=2,max=10"`
-	Transition    string             `json:"transition,omitempty" validate:"omitempty,oneof=none fade dissolve"`
-	FadeDuration  float64            `json:"fade_duration,omitempty" validate:"omitempty,min=0,max=5"`
+	Videos        []types.InputVideo `json:"videos" validate:"required,min=2,max=10,dive"`
+	Transition    string             `json:"transition,omitempty" validate:"omitempty,oneof=none fade dissolve" default:"none"`
+	FadeDuration  float64            `json:"fade_duration,omitempty" validate:"omitempty,min=0,max=5" lenient:"clamp"`
 	AudioStrategy string             `json:"audio_strategy,omitempty" validate:"omitempty,oneof=crossfade concat none cut_continue"`
-	Format        string             `json:"format,omitempty" validate:"omitempty,oneof=mp4 webm"`
+	Format        string             `json:"format,omitempty" validate:"omitempty,oneof=mp4 webm" default:"mp4"`
 	VideoCodec    string             `json:"video_codec,omitempty"` // libx264, libx265, etc.
 	AudioCodec    string             `json:"audio_codec,omitempty"` // aac, mp3, etc.
 }
@@ -18,7 +18,7 @@ type CombineVideosParams struct {
 // TrimVideoParams for trim-video tool
 type TrimVideoParams struct {
 	Video     types.InputVideo `json:"video" validate:"required"`
-	StartTime string           `json:"start_time,omitempty" validate:"omitempty"`
+	StartTime string           `json:"start_time,omitempty" validate:"omitempty"` // Timecode, see types.ParseTimecode
 	EndTime   string           `json:"end_time,omitempty" validate:"required_without=Duration"`
 	Duration  *float64         `json:"duration,omitempty" validate:"required_without=EndTime,omitempty,min=0.1,max=600"`
 	FastMode  *bool            `json:"fast_mode,omitempty"`
@@ -40,23 +40,23 @@ type ExtractFrameParams struct {
 	Video     types.InputVideo `json:"video" validate:"required"`
 	Position  string           `json:"position,omitempty" validate:"omitempty,oneof=first last middle,required_without_all=Timestamp Positions"`
 	Positions []string         `json:"positions,omitempty" validate:"required_without_all=Position Timestamp"` // For batch extraction
-	Timestamp string           `json:"timestamp,omitempty" validate:"required_without_all=Position Positions"`
-	Format    string           `json:"format,omitempty" validate:"omitempty,oneof=jpg png"`
-	Quality   int              `json:"quality,omitempty" validate:"omitempty,min=1,max=100"`
+	Timestamp string           `json:"timestamp,omitempty" validate:"required_without_all=Position Positions"` // Timecode, see types.ParseTimecode
+	Format    string           `json:"format,omitempty" validate:"omitempty,oneof=jpg png" default:"jpg"`
+	Quality   int              `json:"quality,omitempty" validate:"omitempty,min=1,max=100" lenient:"clamp"`
 	Width     int              `json:"width,omitempty" validate:"omitempty,min=1"`
 	Height    int              `json:"height,omitempty" validate:"omitempty,min=1"`
 }
 
 // ImagesToVideoParams for images-to-video tool (slideshow/sequence)
 type ImagesToVideoParams struct {
-	Images             []types.InputImage `json:"images" validate:"required,min=1,max=100"`
-	Duration           float64            `json:"duration,omitempty" validate:"omitempty,min=0.1,max=10"` // Duration per image in seconds
-	Transition         string             `json:"transition,omitempty" validate:"omitempty,oneof=none fade crossfade slide zoom"`
-	TransitionDuration float64            `json:"transition_duration,omitempty" validate:"omitempty,min=0,max=2"`
+	Images             []types.InputImage `json:"images" validate:"required,min=1,max=100,dive"`
+	Duration           float64            `json:"duration,omitempty" validate:"omitempty,min=0.1,max=10" default:"3" lenient:"clamp"` // Duration per image in seconds
+	Transition         string             `json:"transition,omitempty" validate:"omitempty,oneof=none fade crossfade slide zoom" default:"none"`
+	TransitionDuration float64            `json:"transition_duration,omitempty" validate:"omitempty,min=0,max=2" lenient:"clamp"`
 	Audio              *types.InputAudio  `json:"audio,omitempty"` // Optional background audio
-	Format             string             `json:"format,omitempty" validate:"omitempty,oneof=mp4 webm gif"`
+	Format             string             `json:"format,omitempty" validate:"omitempty,oneof=mp4 webm gif" default:"mp4"`
 	Resolution         string             `json:"resolution,omitempty" validate:"omitempty,oneof=1920x1080 1280x720 854x480 640x360"`
-	FPS                int                `json:"fps,omitempty" validate:"omitempty,min=1,max=60"` // Frames per second
+	FPS                int                `json:"fps,omitempty" validate:"omitempty,min=1,max=60" lenient:"clamp"` // Frames per second
 	VideoBitrate       string             `json:"video_bitrate,omitempty" validate:"omitempty"`
 	Loop               bool               `json:"loop,omitempty"` // For GIF output
 	KenBurns           bool               `json:"ken_burns,omitempty"` // Pan and zoom effect
@@ -66,7 +66,7 @@ type ImagesToVideoParams struct {
 
 // MergeImagesParams for merge-images tool
 type MergeImagesParams struct {
-	Images  []types.InputImage `json:"images" validate:"required,min=2"`
+	Images  []types.InputImage `json:"images" validate:"required,min=2,dive"`
 	Layout  string             `json:"layout,omitempty" validate:"omitempty,oneof=horizontal vertical grid"`
 	Spacing int                `json:"spacing,omitempty" validate:"omitempty,min=0"`
 }
\ No newline at end of file
@@ -0,0 +1,456 @@
+package tsgen
+
+import (
+	"fmt"
+	"go/ast"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/metaphi-labs/latent-contracts/tools"
+)
+
+// Header starts every generated file
+const Header = "// Code generated by gen-ts from the Go contracts; DO NOT EDIT.\n"
+
+type generator struct {
+	module  string
+	pkgs    []*goPackage
+	byPath  map[string]*goPackage
+	include map[*typeDecl]bool
+}
+
+// Generate parses the ContractPackages under the module root and returns the
+// declaration files keyed by file name ("errors.d.ts", ..., plus "index.d.ts")
+func Generate(root string) (map[string]string, error) {
+	module, err := modulePath(root)
+	if err != nil {
+		return nil, err
+	}
+	g := &generator{module: module, byPath: make(map[string]*goPackage), include: make(map[*typeDecl]bool)}
+	for _, dir := range ContractPackages {
+		pkg, err := parsePackage(root, dir)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", dir, err)
+		}
+		g.pkgs = append(g.pkgs, pkg)
+		g.byPath[module+"/"+dir] = pkg
+	}
+	g.collect()
+
+	files := make(map[string]string)
+	var index strings.Builder
+	index.WriteString(Header + "\n")
+	for _, pkg := range g.pkgs {
+		src := g.emitPackage(pkg)
+		if src == "" {
+			continue
+		}
+		files[pkg.dir+".d.ts"] = src
+		fmt.Fprintf(&index, "export * as %s from \"./%s\";\n", pkg.dir, pkg.dir)
+	}
+	files["index.d.ts"] = index.String()
+	return files, nil
+}
+
+// collect marks every root type and everything the roots reference
+func (g *generator) collect() {
+	var queue []*typeDecl
+	for _, pkg := range g.pkgs {
+		for _, name := range pkg.order {
+			if d := pkg.specs[name]; d.isRoot() {
+				queue = append(queue, d)
+			}
+		}
+	}
+	for len(queue) > 0 {
+		d := queue[0]
+		queue = queue[1:]
+		if g.include[d] {
+			continue
+		}
+		g.include[d] = true
+		queue = append(queue, g.refs(d, d.spec.Type)...)
+	}
+}
+
+// refs returns the contract types a type expression refers to
+func (g *generator) refs(d *typeDecl, expr ast.Expr) []*typeDecl {
+	switch e := expr.(type) {
+	case *ast.Ident, *ast.SelectorExpr:
+		if ref := g.resolve(d, e); ref != nil {
+			return []*typeDecl{ref}
+		}
+	case *ast.StarExpr:
+		return g.refs(d, e.X)
+	case *ast.ParenExpr:
+		return g.refs(d, e.X)
+	case *ast.ArrayType:
+		return g.refs(d, e.Elt)
+	case *ast.MapType:
+		return g.refs(d, e.Value)
+	case *ast.StructType:
+		var out []*typeDecl
+		for _, field := range e.Fields.List {
+			out = append(out, g.refs(d, field.Type)...)
+		}
+		return out
+	}
+	return nil
+}
+
+// resolve finds the contract type an identifier or selector names, if any
+func (g *generator) resolve(d *typeDecl, expr ast.Expr) *typeDecl {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return d.pkg.specs[e.Name]
+	case *ast.SelectorExpr:
+		x, ok := e.X.(*ast.Ident)
+		if !ok {
+			return nil
+		}
+		if pkg := g.byPath[d.imports[x.Name]]; pkg != nil {
+			return pkg.specs[e.Sel.Name]
+		}
+	}
+	return nil
+}
+
+// file tracks the names a declaration file uses for types from other packages
+type file struct {
+	pkg   *goPackage
+	names map[*typeDecl]string
+}
+
+func (g *generator) emitPackage(pkg *goPackage) string {
+	var decls []*typeDecl
+	local := make(map[string]bool)
+	for _, name := range pkg.order {
+		if d := pkg.specs[name]; g.include[d] {
+			decls = append(decls, d)
+			local[name] = true
+		}
+	}
+	if len(decls) == 0 {
+		return ""
+	}
+
+	// Name imported types, aliasing any that clash with a local or another imported type
+	f := &file{pkg: pkg, names: make(map[*typeDecl]string)}
+	var imported []*typeDecl
+	seen := make(map[*typeDecl]bool)
+	count := make(map[string]int)
+	for _, d := range decls {
+		for _, ref := range g.refs(d, d.spec.Type) {
+			if ref.pkg != pkg && !seen[ref] {
+				seen[ref] = true
+				imported = append(imported, ref)
+				count[ref.name]++
+			}
+		}
+	}
+	for _, ref := range imported {
+		name := ref.name
+		if local[name] || count[name] > 1 {
+			name = ref.pkg.dir + "_" + ref.name
+		}
+		f.names[ref] = name
+	}
+
+	var body strings.Builder
+	for _, d := range decls {
+		body.WriteString("\n")
+		g.emitDecl(&body, f, d)
+	}
+	if pkg.dir == "tools" {
+		g.emitInvocations(&body, f)
+	}
+
+	var out strings.Builder
+	out.WriteString(Header)
+	byPkg := make(map[string][]string)
+	for _, ref := range imported {
+		spec := ref.name
+		if f.names[ref] != ref.name {
+			spec += " as " + f.names[ref]
+		}
+		byPkg[ref.pkg.dir] = append(byPkg[ref.pkg.dir], spec)
+	}
+	dirs := make([]string, 0, len(byPkg))
+	for dir := range byPkg {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+	if len(dirs) > 0 {
+		out.WriteString("\n")
+	}
+	for _, dir := range dirs {
+		sort.Strings(byPkg[dir])
+		fmt.Fprintf(&out, "import type { %s } from \"./%s\";\n", strings.Join(byPkg[dir], ", "), dir)
+	}
+	out.WriteString(body.String())
+	return out.String()
+}
+
+func (g *generator) emitDecl(b *strings.Builder, f *file, d *typeDecl) {
+	writeDoc(b, "", d.doc)
+
+	if values := d.pkg.consts[d.name]; len(values) > 0 {
+		fmt.Fprintf(b, "export type %s =\n", d.name)
+		for i, v := range values {
+			fmt.Fprintf(b, "  | %s", strconv.Quote(v))
+			if i == len(values)-1 {
+				b.WriteString(";")
+			}
+			b.WriteString("\n")
+		}
+		return
+	}
+
+	st, ok := d.spec.Type.(*ast.StructType)
+	if !ok {
+		fmt.Fprintf(b, "export type %s = %s;\n", d.name, g.tsType(f, d, d.spec.Type))
+		return
+	}
+
+	var extends []string
+	var fields strings.Builder
+	for _, field := range st.Fields.List {
+		name, opts := jsonTag(field)
+		if name == "-" && opts == "" {
+			continue
+		}
+		if len(field.Names) == 0 && name == "" {
+			// Embedded struct without a JSON name: its fields are promoted
+			extends = append(extends, g.tsType(f, d, field.Type))
+			continue
+		}
+
+		typ := g.tsType(f, d, field.Type)
+		if typ == "" {
+			continue // funcs and channels aren't marshalled
+		}
+		optional := strings.Contains(opts, "omitempty") || strings.Contains(opts, "omitzero")
+		if _, isPtr := field.Type.(*ast.StarExpr); isPtr && !optional {
+			typ += " | null"
+		}
+
+		names := fieldNames(field)
+		for _, goName := range names {
+			if !ast.IsExported(goName) {
+				continue
+			}
+			key := goName
+			if name != "" {
+				key = name
+			}
+			doc := field.Doc.Text()
+			if doc == "" {
+				doc = field.Comment.Text()
+			}
+			writeDoc(&fields, "  ", doc)
+			mark := ""
+			if optional {
+				mark = "?"
+			}
+			fmt.Fprintf(&fields, "  %s%s: %s;\n", propertyKey(key), mark, typ)
+		}
+	}
+
+	fmt.Fprintf(b, "export interface %s", d.name)
+	if len(extends) > 0 {
+		fmt.Fprintf(b, " extends %s", strings.Join(extends, ", "))
+	}
+	b.WriteString(" {\n")
+	b.WriteString(fields.String())
+	b.WriteString("}\n")
+}
+
+// emitInvocations declares the params of each registered tool, keyed by tool name
+func (g *generator) emitInvocations(b *strings.Builder, f *file) {
+	type entry struct{ tool, params string }
+	var entries []entry
+	for _, name := range tools.RegisteredTools() {
+		def, _ := tools.LookupTool(name)
+		if def.ParamsType.PkgPath() != g.module+"/"+f.pkg.dir {
+			continue
+		}
+		if d := f.pkg.specs[def.ParamsType.Name()]; d != nil && g.include[d] {
+			entries = append(entries, entry{string(name), d.name})
+		}
+	}
+	if len(entries) == 0 {
+		return
+	}
+
+	b.WriteString("\n/** Params type of each registered tool, keyed by tool name */\n")
+	b.WriteString("export interface ToolParamsByName {\n")
+	for _, e := range entries {
+		fmt.Fprintf(b, "  %s: %s;\n", strconv.Quote(e.tool), e.params)
+	}
+	b.WriteString("}\n")
+
+	b.WriteString("\n/** A tool name with its params, discriminated by name */\n")
+	b.WriteString("export type ToolInvocation =\n")
+	for i, e := range entries {
+		fmt.Fprintf(b, "  | { name: %s; parameters: %s }", strconv.Quote(e.tool), e.params)
+		if i == len(entries)-1 {
+			b.WriteString(";")
+		}
+		b.WriteString("\n")
+	}
+}
+
+// tsType renders a Go type expression as TypeScript; "" for types JSON can't carry
+func (g *generator) tsType(f *file, d *typeDecl, expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if ref := g.resolve(d, e); ref != nil {
+			return f.name(ref)
+		}
+		return basicType(e.Name)
+	case *ast.SelectorExpr:
+		if ref := g.resolve(d, e); ref != nil {
+			return f.name(ref)
+		}
+		x, _ := e.X.(*ast.Ident)
+		if x != nil {
+			switch d.imports[x.Name] + "." + e.Sel.Name {
+			case "time.Time":
+				return "string"
+			case "time.Duration":
+				return "number"
+			}
+		}
+		return "unknown"
+	case *ast.StarExpr:
+		return g.tsType(f, d, e.X)
+	case *ast.ParenExpr:
+		return g.tsType(f, d, e.X)
+	case *ast.ArrayType:
+		if id, ok := e.Elt.(*ast.Ident); ok && id.Name == "byte" {
+			return "string" // base64
+		}
+		elem := g.tsType(f, d, e.Elt)
+		if elem == "" {
+			return ""
+		}
+		if strings.Contains(elem, " ") {
+			elem = "(" + elem + ")"
+		}
+		return elem + "[]"
+	case *ast.MapType:
+		value := g.tsType(f, d, e.Value)
+		if value == "" {
+			return ""
+		}
+		return "Record<string, " + value + ">"
+	case *ast.InterfaceType:
+		return "unknown"
+	case *ast.StructType:
+		var parts []string
+		for _, field := range e.Fields.List {
+			name, opts := jsonTag(field)
+			typ := g.tsType(f, d, field.Type)
+			if typ == "" || (name == "-" && opts == "") {
+				continue
+			}
+			mark := ""
+			if strings.Contains(opts, "omitempty") {
+				mark = "?"
+			}
+			for _, goName := range fieldNames(field) {
+				key := goName
+				if name != "" {
+					key = name
+				}
+				parts = append(parts, fmt.Sprintf("%s%s: %s", propertyKey(key), mark, typ))
+			}
+		}
+		return "{ " + strings.Join(parts, "; ") + " }"
+	}
+	return ""
+}
+
+// name is how a file refers to a contract type
+func (f *file) name(d *typeDecl) string {
+	if d.pkg == f.pkg {
+		return d.name
+	}
+	return f.names[d]
+}
+
+func basicType(name string) string {
+	switch name {
+	case "string":
+		return "string"
+	case "bool":
+		return "boolean"
+	case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64",
+		"float32", "float64", "byte", "rune", "uintptr":
+		return "number"
+	}
+	return "unknown"
+}
+
+func jsonTag(field *ast.Field) (name, opts string) {
+	if field.Tag == nil {
+		return "", ""
+	}
+	tag, err := strconv.Unquote(field.Tag.Value)
+	if err != nil {
+		return "", ""
+	}
+	name, opts, _ = strings.Cut(reflect.StructTag(tag).Get("json"), ",")
+	return name, opts
+}
+
+// fieldNames returns a field's Go names; an embedded field is named after its type
+func fieldNames(field *ast.Field) []string {
+	if len(field.Names) > 0 {
+		names := make([]string, len(field.Names))
+		for i, n := range field.Names {
+			names[i] = n.Name
+		}
+		return names
+	}
+	expr := field.Type
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return []string{e.Name}
+	case *ast.SelectorExpr:
+		return []string{e.Sel.Name}
+	}
+	return nil
+}
+
+// propertyKey quotes keys that aren't valid identifiers
+func propertyKey(key string) string {
+	for i, r := range key {
+		if !(r == '_' || r == '$' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || i > 0 && r >= '0' && r <= '9') {
+			return strconv.Quote(key)
+		}
+	}
+	return key
+}
+
+func writeDoc(b *strings.Builder, indent, doc string) {
+	doc = strings.TrimSpace(strings.ReplaceAll(doc, "*/", "*\\/"))
+	if doc == "" {
+		return
+	}
+	lines := strings.Split(doc, "\n")
+	if len(lines) == 1 {
+		fmt.Fprintf(b, "%s/** %s */\n", indent, lines[0])
+		return
+	}
+	fmt.Fprintf(b, "%s/**\n", indent)
+	for _, line := range lines {
+		fmt.Fprintf(b, "%s * %s\n", indent, strings.TrimRight(line, " "))
+	}
+	fmt.Fprintf(b, "%s */\n", indent)
+}
@@ -0,0 +1,85 @@
+package tsgen
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/metaphi-labs/latent-contracts/errors"
+	"github.com/metaphi-labs/latent-contracts/tools"
+)
+
+func generate(t *testing.T) map[string]string {
+	t.Helper()
+	files, err := Generate("..")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return files
+}
+
+// union returns the members of an exported string-literal union in src
+func union(t *testing.T, src, name string) map[string]bool {
+	t.Helper()
+	start := strings.Index(src, "export type "+name+" =\n")
+	if start < 0 {
+		t.Fatalf("no union %s", name)
+	}
+	body := src[start:]
+	body = body[:strings.Index(body, ";")]
+	members := make(map[string]bool)
+	for _, line := range strings.Split(body, "\n")[1:] {
+		members[strings.Trim(strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), "|")), `"`)] = true
+	}
+	return members
+}
+
+func TestGenerateFiles(t *testing.T) {
+	files := generate(t)
+	for _, dir := range []string{"errors", "tools", "messages"} {
+		src, exists := files[dir+".d.ts"]
+		if !exists {
+			t.Errorf("no %s.d.ts", dir)
+			continue
+		}
+		if !strings.HasPrefix(src, Header) {
+			t.Errorf("%s.d.ts does not start with the generated header", dir)
+		}
+		if !strings.Contains(files["index.d.ts"], fmt.Sprintf("export * as %s from \"./%s\";", dir, dir)) {
+			t.Errorf("index.d.ts does not export %s", dir)
+		}
+	}
+}
+
+func TestGenerateToolNameUnion(t *testing.T) {
+	names := union(t, generate(t)["tools.d.ts"], "ToolName")
+	for _, name := range tools.RegisteredTools() {
+		if !names[string(name)] {
+			t.Errorf("ToolName is missing %q", name)
+		}
+	}
+}
+
+func TestGenerateErrorCodeUnion(t *testing.T) {
+	codes := union(t, generate(t)["errors.d.ts"], "ErrorCode")
+	for _, code := range []errors.ErrorCode{errors.VAL_INVALID_PARAMETER, errors.TOOL_TIMEOUT, errors.AUTH_FORBIDDEN} {
+		if !codes[string(code)] {
+			t.Errorf("ErrorCode is missing %q", code)
+		}
+	}
+}
+
+func TestGenerateToolParamsByName(t *testing.T) {
+	src := generate(t)["tools.d.ts"]
+	start := strings.Index(src, "export interface ToolParamsByName {\n")
+	if start < 0 {
+		t.Fatal("no ToolParamsByName")
+	}
+	body := src[start : start+strings.Index(src[start:], "}")]
+	for _, name := range tools.RegisteredTools() {
+		def, _ := tools.LookupTool(name)
+		if entry := fmt.Sprintf("  %q: %s;\n", name, def.ParamsType.Name()); !strings.Contains(body, entry) {
+			t.Errorf("ToolParamsByName is missing %q", strings.TrimSpace(entry))
+		}
+	}
+}
@@ -0,0 +1,162 @@
+// Package tsgen generates TypeScript declaration files (.d.ts) from the contract packages,
+// so frontend and Node services share the Go types instead of re-declaring them.
+//
+// Shapes follow encoding/json: `json` tag names are used, `omitempty` fields are optional
+// and non-omitempty pointers may be null. String types with constants (ErrorCode,
+// EventType, progress.Status, ToolName...) become string-literal unions, and the tools
+// declarations include a union of tool invocations discriminated by tool name.
+package tsgen
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ContractPackages are the packages published as declaration files, relative to the module root
+var ContractPackages = []string{
+	"batch", "callbacks", "errors", "events", "messages", "pricing", "progress", "results", "tools", "types",
+	"workflow",
+}
+
+// goPackage holds the declarations parsed from one contract package
+type goPackage struct {
+	dir    string // Relative to the module root; also the declaration file name
+	specs  map[string]*typeDecl
+	order  []string            // Type names in declaration order
+	consts map[string][]string // String constant values by type name, in declaration order
+}
+
+// typeDecl is one exported type declaration
+type typeDecl struct {
+	pkg     *goPackage
+	name    string
+	spec    *ast.TypeSpec
+	doc     string
+	imports map[string]string // Import name -> path for the declaring file
+}
+
+// parsePackage parses the non-test Go files of a package directory
+func parsePackage(root, dir string) (*goPackage, error) {
+	entries, err := os.ReadDir(filepath.Join(root, dir))
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if !entry.IsDir() && strings.HasSuffix(name, ".go") && !strings.HasSuffix(name, "_test.go") {
+			files = append(files, name)
+		}
+	}
+	sort.Strings(files)
+
+	pkg := &goPackage{dir: dir, specs: make(map[string]*typeDecl), consts: make(map[string][]string)}
+	fset := token.NewFileSet()
+	for _, name := range files {
+		file, err := parser.ParseFile(fset, filepath.Join(root, dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		imports := fileImports(file)
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+			switch gen.Tok {
+			case token.TYPE:
+				for _, s := range gen.Specs {
+					spec := s.(*ast.TypeSpec)
+					if !spec.Name.IsExported() || spec.TypeParams != nil {
+						continue
+					}
+					doc := spec.Doc
+					if doc == nil && len(gen.Specs) == 1 {
+						doc = gen.Doc
+					}
+					pkg.specs[spec.Name.Name] = &typeDecl{
+						pkg: pkg, name: spec.Name.Name, spec: spec, doc: doc.Text(), imports: imports,
+					}
+					pkg.order = append(pkg.order, spec.Name.Name)
+				}
+			case token.CONST:
+				for _, s := range gen.Specs {
+					collectConsts(pkg, s.(*ast.ValueSpec))
+				}
+			}
+		}
+	}
+	return pkg, nil
+}
+
+// collectConsts records typed string constants, e.g. `EventToolStarted EventType = "media.tool.started"`
+func collectConsts(pkg *goPackage, spec *ast.ValueSpec) {
+	typ, ok := spec.Type.(*ast.Ident)
+	if !ok {
+		return
+	}
+	for i, name := range spec.Names {
+		if !name.IsExported() || i >= len(spec.Values) {
+			continue
+		}
+		lit, ok := spec.Values[i].(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			continue
+		}
+		if value, err := strconv.Unquote(lit.Value); err == nil {
+			pkg.consts[typ.Name] = append(pkg.consts[typ.Name], value)
+		}
+	}
+}
+
+func fileImports(file *ast.File) map[string]string {
+	imports := make(map[string]string)
+	for _, imp := range file.Imports {
+		path, _ := strconv.Unquote(imp.Path.Value)
+		name := filepath.Base(path)
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+		imports[name] = path
+	}
+	return imports
+}
+
+// modulePath reads the module path from root/go.mod
+func modulePath(root string) (string, error) {
+	data, err := os.ReadFile(filepath.Join(root, "go.mod"))
+	if err != nil {
+		return "", err
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if rest, ok := strings.CutPrefix(strings.TrimSpace(line), "module "); ok {
+			return strings.Trim(strings.TrimSpace(rest), `"`), nil
+		}
+	}
+	return "", fmt.Errorf("%s/go.mod has no module line", root)
+}
+
+// isRoot reports whether a type is published on its own: a struct with JSON tags
+// or a string type with constants. Other types are published only when referenced.
+func (d *typeDecl) isRoot() bool {
+	if len(d.pkg.consts[d.name]) > 0 {
+		return true
+	}
+	st, ok := d.spec.Type.(*ast.StructType)
+	if !ok {
+		return false
+	}
+	for _, field := range st.Fields.List {
+		if field.Tag != nil && strings.Contains(field.Tag.Value, `json:"`) {
+			return true
+		}
+	}
+	return false
+}
@@ -6,7 +6,7 @@ import "time"
 
 // InputImage represents an image provided as input to a tool
 type InputImage struct {
-	StorageURL string `json:"storage_url"`           // GCS URL (gs://)
+	StorageURL string `json:"storage_url" validate:"required"` // GCS URL (gs://)
 	MimeType   string `json:"mime_type"`             // MIME type
 	FileSize   int64  `json:"file_size_bytes"`       // Required for input validation
 	Width      *int   `json:"width,omitempty"`       // Optional dimensions
@@ -15,7 +15,7 @@ type InputImage struct {
 
 // InputVideo represents a video provided as input to a tool
 type InputVideo struct {
-	StorageURL string   `json:"storage_url"`         // GCS URL (gs://)
+	StorageURL string   `json:"storage_url" validate:"required"` // GCS URL (gs://)
 	MimeType   string   `json:"mime_type"`           // MIME type
 	FileSize   int64    `json:"file_size_bytes"`     // Required for input validation
 	Duration   *float64 `json:"duration_seconds,omitempty"`
@@ -25,7 +25,7 @@ type InputVideo struct {
 
 // InputAudio represents audio provided as input to a tool
 type InputAudio struct {
-	StorageURL string   `json:"storage_url"`         // GCS URL (gs://)
+	StorageURL string   `json:"storage_url" validate:"required"` // GCS URL (gs://)
 	MimeType   string   `json:"mime_type"`           // MIME type
 	FileSize   int64    `json:"file_size_bytes"`     // Required for input validation
 	Duration   *float64 `json:"duration_seconds,omitempty"`
@@ -0,0 +1,161 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// DefaultFrameRate resolves frame-based timecodes when the media frame rate is unknown
+const DefaultFrameRate = 24.0
+
+// TimecodeFormats describes the accepted timecode forms, for error messages
+const TimecodeFormats = "seconds (5, 5.5, 5s), MM:SS, HH:MM:SS.mmm, HH:MM:SS:FF or frames (120f)"
+
+// Timecode is a position in a media timeline.
+// Frame-based forms keep their frame count so they can be resolved against
+// the actual frame rate of the media.
+type Timecode struct {
+	seconds float64 // Time portion in seconds
+	frames  int     // Additional frames, resolved with a frame rate
+}
+
+// ParseTimecode parses a timecode in any of the forms listed in TimecodeFormats
+func ParseTimecode(s string) (Timecode, error) {
+	value := strings.TrimSpace(s)
+	if value == "" {
+		return Timecode{}, fmt.Errorf("empty timecode: expected %s", TimecodeFormats)
+	}
+
+	// Frame count: "120f"
+	if strings.HasSuffix(value, "f") {
+		frames, err := parseUnit(strings.TrimSuffix(value, "f"), -1)
+		if err != nil {
+			return Timecode{}, invalidTimecode(s)
+		}
+		return Timecode{frames: frames}, nil
+	}
+
+	// Plain seconds: "5", "5.5", "5s"
+	if !strings.Contains(value, ":") {
+		seconds, err := parseSeconds(strings.TrimSuffix(value, "s"))
+		if err != nil {
+			return Timecode{}, invalidTimecode(s)
+		}
+		return Timecode{seconds: seconds}, nil
+	}
+
+	// SMPTE drop-frame notation uses ';' before the frame count
+	parts := strings.Split(strings.Replace(value, ";", ":", 1), ":")
+	var hours, minutes, frames int
+	var secondsPart string
+	var err error
+
+	switch len(parts) {
+	case 2: // MM:SS(.mmm)
+		minutes, err = parseUnit(parts[0], -1)
+		secondsPart = parts[1]
+	case 3: // HH:MM:SS(.mmm)
+		hours, err = parseUnit(parts[0], -1)
+		if err == nil {
+			minutes, err = parseUnit(parts[1], 59)
+		}
+		secondsPart = parts[2]
+	case 4: // HH:MM:SS:FF
+		hours, err = parseUnit(parts[0], -1)
+		if err == nil {
+			minutes, err = parseUnit(parts[1], 59)
+		}
+		if err == nil {
+			frames, err = parseUnit(parts[3], -1)
+		}
+		if strings.Contains(parts[2], ".") {
+			err = fmt.Errorf("fractional seconds with frame count")
+		}
+		secondsPart = parts[2]
+	default:
+		return Timecode{}, invalidTimecode(s)
+	}
+	if err != nil {
+		return Timecode{}, invalidTimecode(s)
+	}
+
+	seconds, err := parseSeconds(secondsPart)
+	if err != nil || seconds >= 60 {
+		return Timecode{}, invalidTimecode(s)
+	}
+
+	return Timecode{
+		seconds: float64(hours*3600+minutes*60) + seconds,
+		frames:  frames,
+	}, nil
+}
+
+// TimecodeFromSeconds creates a timecode at an exact number of seconds
+func TimecodeFromSeconds(seconds float64) Timecode {
+	return Timecode{seconds: seconds}
+}
+
+// IsFrameBased returns true if the timecode includes a frame count
+func (t Timecode) IsFrameBased() bool {
+	return t.frames > 0
+}
+
+// Seconds resolves the timecode to seconds.
+// Frame counts are converted with fps, or DefaultFrameRate when fps is not positive.
+func (t Timecode) Seconds(fps float64) float64 {
+	if t.frames == 0 {
+		return t.seconds
+	}
+	if fps <= 0 {
+		fps = DefaultFrameRate
+	}
+	return t.seconds + float64(t.frames)/fps
+}
+
+// String formats the timecode as HH:MM:SS.mmm, HH:MM:SS:FF or <n>f matching its form
+func (t Timecode) String() string {
+	if t.seconds == 0 && t.frames > 0 {
+		return fmt.Sprintf("%df", t.frames)
+	}
+	totalMillis := int64(math.Round(t.seconds * 1000))
+	whole := totalMillis / 1000
+	hours, minutes, secs := whole/3600, (whole%3600)/60, whole%60
+	if t.frames > 0 {
+		return fmt.Sprintf("%02d:%02d:%02d:%02d", hours, minutes, secs, t.frames)
+	}
+	return fmt.Sprintf("%02d:%02d:%02d.%03d", hours, minutes, secs, totalMillis%1000)
+}
+
+// decimalSeconds matches plain decimals; ParseFloat alone also takes hex, exponent and Inf forms
+var decimalSeconds = regexp.MustCompile(`^\d+(\.\d+)?$`)
+
+// parseSeconds parses a non-negative decimal number of seconds
+func parseSeconds(s string) (float64, error) {
+	if !decimalSeconds.MatchString(s) {
+		return 0, fmt.Errorf("invalid seconds %q", s)
+	}
+	seconds, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsInf(seconds, 0) || math.IsNaN(seconds) {
+		return 0, fmt.Errorf("invalid seconds %q", s)
+	}
+	return seconds, nil
+}
+
+// parseUnit parses a non-negative integer timecode unit, capped at max when max >= 0
+func parseUnit(s string, max int) (int, error) {
+	if s == "" || strings.ContainsAny(s, "+-") {
+		return 0, fmt.Errorf("invalid unit %q", s)
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || (max >= 0 && n > max) {
+		return 0, fmt.Errorf("invalid unit %q", s)
+	}
+	return n, nil
+}
+
+func invalidTimecode(s string) error {
+	return fmt.Errorf("invalid timecode %q: expected %s", s, TimecodeFormats)
+}
@@ -0,0 +1,33 @@
+package types
+
+import "testing"
+
+func TestParseTimecodeAcceptsPlainDecimals(t *testing.T) {
+	cases := map[string]float64{
+		"5":            5,
+		"5.5":          5.5,
+		"5s":           5,
+		"01:30":        90,
+		"00:01:02.500": 62.5,
+	}
+	for input, want := range cases {
+		tc, err := ParseTimecode(input)
+		if err != nil {
+			t.Errorf("%q: %v", input, err)
+			continue
+		}
+		if got := tc.Seconds(DefaultFrameRate); got != want {
+			t.Errorf("%q = %gs, want %gs", input, got, want)
+		}
+	}
+}
+
+func TestParseTimecodeRejectsNonDecimalNumbers(t *testing.T) {
+	for _, input := range []string{
+		"0x1p4", "0x10", "Inf", "inf", "NaN", "1e3", "+5", "-5", "5.", ".5", "1_000", "01:0x1p4", "+120f",
+	} {
+		if _, err := ParseTimecode(input); err == nil {
+			t.Errorf("%q was accepted", input)
+		}
+	}
+}
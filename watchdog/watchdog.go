@@ -0,0 +1,149 @@
+// Package watchdog fails jobs that run past their tool's timeout
+// (tools.ToolMeta.Duration.Timeout), producing the TOOL_TIMEOUT ServiceError and the
+// EventToolFailed event a service would have sent had the job failed on its own.
+package watchdog
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/metaphi-labs/latent-contracts/errors"
+	"github.com/metaphi-labs/latent-contracts/events"
+	"github.com/metaphi-labs/latent-contracts/tools"
+)
+
+// Job is a running tool job being watched
+type Job struct {
+	JobID          string
+	Tool           string
+	UserID         string
+	ConversationID string
+	Service        string // Service running the job, reported in the error and event
+	StartedAt      time.Time
+}
+
+// Timeout is an overdue job with the error and event to publish for it
+type Timeout struct {
+	Job      Job
+	Deadline time.Time
+	Error    *errors.ServiceError
+	Event    *events.MediaEvent
+}
+
+// Watchdog tracks running jobs and reports those past their deadline.
+// It is safe for concurrent use.
+type Watchdog struct {
+	mu   sync.Mutex
+	jobs map[string]watched
+	now  func() time.Time
+}
+
+type watched struct {
+	job      Job
+	deadline time.Time
+	timeout  time.Duration
+}
+
+// New creates an empty watchdog
+func New() *Watchdog {
+	return &Watchdog{jobs: make(map[string]watched), now: time.Now}
+}
+
+// WithClock replaces time.Now, for tests
+func (w *Watchdog) WithClock(now func() time.Time) *Watchdog {
+	w.now = now
+	return w
+}
+
+// Track starts watching a job. Its deadline is StartedAt (now when zero) plus the
+// tool's timeout, or tools.DefaultTimeout when the tool declares none.
+func (w *Watchdog) Track(job Job) (deadline time.Time, err error) {
+	name, _, exists := tools.ResolveToolName(job.Tool)
+	if !exists {
+		return time.Time{}, fmt.Errorf("unknown tool: %s", job.Tool)
+	}
+	meta, _ := tools.GetToolMetadata(name)
+	timeout := meta.Duration.TimeoutOrDefault()
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if job.StartedAt.IsZero() {
+		job.StartedAt = w.now()
+	}
+	deadline = job.StartedAt.Add(timeout)
+	w.jobs[job.JobID] = watched{job: job, deadline: deadline, timeout: timeout}
+	return deadline, nil
+}
+
+// Done stops watching a job that finished on its own
+func (w *Watchdog) Done(jobID string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	delete(w.jobs, jobID)
+}
+
+// Deadline returns when a watched job times out
+func (w *Watchdog) Deadline(jobID string) (time.Time, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	job, exists := w.jobs[jobID]
+	return job.deadline, exists
+}
+
+// Overdue stops watching every job past its deadline and returns their timeouts,
+// earliest deadline first
+func (w *Watchdog) Overdue() []Timeout {
+	w.mu.Lock()
+	now := w.now()
+	var overdue []watched
+	for id, job := range w.jobs {
+		if !now.Before(job.deadline) {
+			overdue = append(overdue, job)
+			delete(w.jobs, id)
+		}
+	}
+	w.mu.Unlock()
+
+	sort.Slice(overdue, func(i, j int) bool { return overdue[i].deadline.Before(overdue[j].deadline) })
+	timeouts := make([]Timeout, 0, len(overdue))
+	for _, job := range overdue {
+		timeouts = append(timeouts, newTimeout(job))
+	}
+	return timeouts
+}
+
+// Run checks for overdue jobs every interval and hands each timeout to handle
+// until ctx is done
+func (w *Watchdog) Run(ctx context.Context, interval time.Duration, handle func(Timeout)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, timeout := range w.Overdue() {
+				handle(timeout)
+			}
+		}
+	}
+}
+
+func newTimeout(w watched) Timeout {
+	job := w.job
+	message := fmt.Sprintf("Tool '%s' did not finish within %s", job.Tool, w.timeout)
+	err := errors.NewServiceError(errors.TOOL_TIMEOUT, message, job.Service, true)
+	err.JobID = job.JobID
+	err.UserID = job.UserID
+
+	return Timeout{
+		Job:      job,
+		Deadline: w.deadline,
+		Error:    err,
+		Event: events.NewMediaFailed(job.JobID, job.Tool, job.UserID, job.ConversationID, job.Service,
+			string(errors.TOOL_TIMEOUT), message),
+	}
+}
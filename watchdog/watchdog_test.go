@@ -0,0 +1,119 @@
+package watchdog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/metaphi-labs/latent-contracts/errors"
+	"github.com/metaphi-labs/latent-contracts/events"
+	"github.com/metaphi-labs/latent-contracts/tools"
+)
+
+var epoch = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+type clock struct{ now time.Time }
+
+func (c *clock) Now() time.Time {
+	return c.now
+}
+
+func timeout(t *testing.T, name tools.ToolName) time.Duration {
+	t.Helper()
+	meta, exists := tools.GetToolMetadata(name)
+	if !exists {
+		t.Fatalf("%s is not registered", name)
+	}
+	return meta.Duration.TimeoutOrDefault()
+}
+
+func track(t *testing.T, w *Watchdog, job Job) time.Time {
+	t.Helper()
+	deadline, err := w.Track(job)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return deadline
+}
+
+func TestTrackUsesToolTimeout(t *testing.T) {
+	c := &clock{now: epoch}
+	w := New().WithClock(c.Now)
+
+	deadline := track(t, w, Job{JobID: "job-1", Tool: string(tools.GenerateVideoVeo3)})
+	if want := epoch.Add(timeout(t, tools.GenerateVideoVeo3)); !deadline.Equal(want) {
+		t.Errorf("deadline = %s, want %s", deadline, want)
+	}
+
+	started := epoch.Add(-time.Minute)
+	deadline = track(t, w, Job{JobID: "job-2", Tool: string(tools.GenerateImageImagen), StartedAt: started})
+	if want := started.Add(timeout(t, tools.GenerateImageImagen)); !deadline.Equal(want) {
+		t.Errorf("deadline from StartedAt = %s, want %s", deadline, want)
+	}
+
+	if _, err := w.Track(Job{JobID: "job-3", Tool: "no-such-tool"}); err == nil {
+		t.Error("tracked a job for an unknown tool")
+	}
+}
+
+func TestOverdueReportsTimeouts(t *testing.T) {
+	c := &clock{now: epoch}
+	w := New().WithClock(c.Now)
+
+	video := Job{JobID: "video", Tool: string(tools.GenerateVideoVeo3), UserID: "user-1", ConversationID: "conv-1", Service: "media-ai"}
+	image := Job{JobID: "image", Tool: string(tools.GenerateImageImagen), UserID: "user-1", ConversationID: "conv-1", Service: "media-ai"}
+	videoDeadline := track(t, w, video)
+	imageDeadline := track(t, w, image)
+	if !imageDeadline.Before(videoDeadline) {
+		t.Fatalf("test needs the image deadline (%s) before the video deadline (%s)", imageDeadline, videoDeadline)
+	}
+
+	c.now = imageDeadline.Add(-time.Second)
+	if overdue := w.Overdue(); len(overdue) != 0 {
+		t.Fatalf("%d jobs overdue before any deadline", len(overdue))
+	}
+
+	c.now = videoDeadline
+	overdue := w.Overdue()
+	if len(overdue) != 2 {
+		t.Fatalf("%d jobs overdue, want 2", len(overdue))
+	}
+	if overdue[0].Job.JobID != "image" || overdue[1].Job.JobID != "video" {
+		t.Errorf("overdue order = %s, %s, want earliest deadline first", overdue[0].Job.JobID, overdue[1].Job.JobID)
+	}
+
+	first := overdue[0]
+	if !first.Deadline.Equal(imageDeadline) {
+		t.Errorf("deadline = %s, want %s", first.Deadline, imageDeadline)
+	}
+	if first.Error.Code != errors.TOOL_TIMEOUT || !first.Error.Retryable || first.Error.JobID != "image" || first.Error.UserID != "user-1" {
+		t.Errorf("error = %+v", first.Error)
+	}
+	data, _ := first.Event.Data.(events.MediaEventData)
+	if first.Event.Type != events.EventToolFailed || data.JobID != "image" ||
+		data.Reason != string(errors.TOOL_TIMEOUT) || data.ConversationID != "conv-1" {
+		t.Errorf("event = %+v", first.Event)
+	}
+
+	if _, watched := w.Deadline("image"); watched {
+		t.Error("an overdue job is still watched")
+	}
+	if again := w.Overdue(); len(again) != 0 {
+		t.Errorf("%d timeouts reported twice", len(again))
+	}
+}
+
+func TestDoneStopsWatching(t *testing.T) {
+	c := &clock{now: epoch}
+	w := New().WithClock(c.Now)
+
+	deadline := track(t, w, Job{JobID: "job-1", Tool: string(tools.GenerateVideoVeo3)})
+	w.Done("job-1")
+	if _, watched := w.Deadline("job-1"); watched {
+		t.Error("a finished job is still watched")
+	}
+
+	c.now = deadline.Add(time.Hour)
+	if overdue := w.Overdue(); len(overdue) != 0 {
+		t.Errorf("a finished job timed out: %+v", overdue[0].Job)
+	}
+}
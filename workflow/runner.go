@@ -0,0 +1,297 @@
+package workflow
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"time"
+
+	"github.com/metaphi-labs/latent-contracts/errors"
+	"github.com/metaphi-labs/latent-contracts/messages"
+	"github.com/metaphi-labs/latent-contracts/pricing"
+	"github.com/metaphi-labs/latent-contracts/progress"
+	"github.com/metaphi-labs/latent-contracts/results"
+	"github.com/metaphi-labs/latent-contracts/tools"
+	"github.com/metaphi-labs/latent-contracts/types"
+)
+
+// Executor runs one step of a workflow. Params in call have references resolved.
+// A failed tool run is reported either as an error or as a result with Success false.
+type Executor interface {
+	Execute(ctx context.Context, call messages.ToolCall, plan messages.PlanContext) (*results.ToolResult, error)
+}
+
+// ExecutorFunc adapts a function to Executor
+type ExecutorFunc func(ctx context.Context, call messages.ToolCall, plan messages.PlanContext) (*results.ToolResult, error)
+
+// Execute calls f
+func (f ExecutorFunc) Execute(ctx context.Context, call messages.ToolCall, plan messages.PlanContext) (*results.ToolResult, error) {
+	return f(ctx, call, plan)
+}
+
+// StepRun is the outcome of one step
+type StepRun struct {
+	Ref    string                 `json:"ref"`
+	Tool   tools.ToolName         `json:"tool"`
+	Status progress.Status        `json:"status"`           // completed, failed, or cancelled when a dependency failed
+	Params map[string]interface{} `json:"params,omitempty"` // As executed, references resolved
+	Result *results.ToolResult    `json:"result,omitempty"`
+	Error  *errors.ServiceError   `json:"error,omitempty"`
+}
+
+// RunResult is the outcome of a workflow run, steps in execution order
+type RunResult struct {
+	WorkflowID string    `json:"workflow_id"`
+	Steps      []StepRun `json:"steps"`
+	Credits    int       `json:"credits"` // Sum of CreditsUsed over completed steps
+}
+
+// Succeeded reports whether every step completed
+func (r *RunResult) Succeeded() bool {
+	for _, step := range r.Steps {
+		if step.Status != progress.StatusCompleted {
+			return false
+		}
+	}
+	return true
+}
+
+// Step returns the run of the step with a ref
+func (r *RunResult) Step(ref string) (StepRun, bool) {
+	for _, step := range r.Steps {
+		if step.Ref == ref {
+			return step, true
+		}
+	}
+	return StepRun{}, false
+}
+
+// Runner executes workflows in memory, one step at a time in plan order.
+// Steps whose dependencies failed are cancelled rather than run.
+type Runner struct {
+	Executor       Executor
+	ConversationID string // Copied onto every messages.ToolCall
+	UserID         string
+}
+
+// NewRunner creates a runner for an executor
+func NewRunner(executor Executor) *Runner {
+	return &Runner{Executor: executor}
+}
+
+// Run validates a workflow and executes it. Validation errors are returned before any
+// step runs; tool failures are recorded in the RunResult instead.
+func (r *Runner) Run(ctx context.Context, w *Workflow) (*RunResult, error) {
+	plan, err := Validate(w)
+	if err != nil {
+		return nil, err
+	}
+
+	steps := make(map[string]Step, len(w.Steps))
+	for _, step := range w.Steps {
+		steps[step.Ref] = step
+	}
+	run := &RunResult{WorkflowID: w.ID}
+	outcomes := make(map[string]StepRun, len(plan.Steps))
+
+	for i, planned := range plan.Steps {
+		stepRun := StepRun{Ref: planned.Ref, Tool: planned.Tool}
+		switch {
+		case ctx.Err() != nil:
+			stepRun.Status = progress.StatusCancelled
+			stepRun.Error = errors.NewServiceError(errors.TOOL_EXECUTION_FAILED,
+				fmt.Sprintf("Workflow cancelled before step '%s': %v", planned.Ref, ctx.Err()), "workflow", false)
+		case failedDependency(planned, outcomes) != "":
+			stepRun.Status = progress.StatusCancelled
+			stepRun.Error = errors.NewServiceError(errors.VAL_DEPENDENCY_MISSING,
+				fmt.Sprintf("Step '%s' skipped because step '%s' did not complete", planned.Ref, failedDependency(planned, outcomes)),
+				"workflow", false)
+		default:
+			r.runStep(ctx, &stepRun, steps[planned.Ref], messages.PlanContext{
+				PlanID:     w.ID,
+				StepIndex:  i,
+				TotalSteps: len(plan.Steps),
+				IsLastStep: i == len(plan.Steps)-1,
+				StepRef:    planned.Ref,
+			}, outcomes)
+		}
+
+		outcomes[stepRun.Ref] = stepRun
+		run.Steps = append(run.Steps, stepRun)
+		if stepRun.Status == progress.StatusCompleted {
+			run.Credits += stepRun.Result.Metadata.CreditsUsed
+		}
+	}
+	return run, nil
+}
+
+func (r *Runner) runStep(ctx context.Context, stepRun *StepRun, step Step, plan messages.PlanContext, outcomes map[string]StepRun) {
+	stepRun.Status = progress.StatusFailed
+
+	params, err := resolveRefs(step.Params, func(ref Reference) ([]interface{}, error) {
+		return outputMedia(outcomes[ref.Step].Result, ref)
+	})
+	if err != nil {
+		stepRun.Error = errors.NewServiceError(errors.TOOL_INVALID_PARAMS, err.Error(), "workflow", false)
+		return
+	}
+	stepRun.Params, _ = params.(map[string]interface{})
+
+	result, err := r.Executor.Execute(ctx, messages.ToolCall{
+		Ref:            step.Ref,
+		Name:           string(stepRun.Tool),
+		Parameters:     stepRun.Params,
+		ConversationID: r.ConversationID,
+		UserID:         r.UserID,
+	}, plan)
+	stepRun.Result = result
+	switch {
+	case err != nil:
+		var serviceErr *errors.ServiceError
+		if !stderrors.As(err, &serviceErr) {
+			serviceErr = errors.NewServiceError(errors.TOOL_EXECUTION_FAILED, err.Error(), "workflow", false).WithCause(err)
+		}
+		stepRun.Error = serviceErr
+	case result == nil:
+		stepRun.Error = errors.NewServiceError(errors.TOOL_EXECUTION_FAILED,
+			fmt.Sprintf("Step '%s' returned no result", step.Ref), "workflow", false)
+	case !result.Success:
+		stepRun.Error = result.Error
+	default:
+		stepRun.Status = progress.StatusCompleted
+	}
+}
+
+// failedDependency returns the first dependency of a step that didn't complete
+func failedDependency(planned PlannedStep, outcomes map[string]StepRun) string {
+	for _, dep := range planned.DependsOn {
+		if outcomes[dep].Status != progress.StatusCompleted {
+			return dep
+		}
+	}
+	return ""
+}
+
+// outputMedia turns the media a reference selects from a result into input params
+func outputMedia(result *results.ToolResult, ref Reference) ([]interface{}, error) {
+	var images []types.OutputImage
+	var videos []types.OutputVideo
+	var audio []types.OutputAudio
+	if result != nil && result.MediaGeneration != nil {
+		images, videos, audio = result.MediaGeneration.Images, result.MediaGeneration.Videos, result.MediaGeneration.Audio
+	}
+	if result != nil && result.VideoProcessing != nil {
+		images, videos, audio = result.VideoProcessing.Images, result.VideoProcessing.Videos, result.VideoProcessing.Audio
+	}
+
+	var inputs []interface{}
+	switch ref.Output {
+	case OutputImages:
+		for _, img := range images {
+			inputs = append(inputs, types.InputImage{StorageURL: img.StorageURL, MimeType: img.MimeType, Width: img.Width, Height: img.Height})
+		}
+	case OutputVideos:
+		for _, vid := range videos {
+			inputs = append(inputs, types.InputVideo{StorageURL: vid.StorageURL, MimeType: vid.MimeType, Duration: vid.Duration, Width: vid.Width, Height: vid.Height})
+		}
+	case OutputAudio:
+		for _, aud := range audio {
+			inputs = append(inputs, types.InputAudio{StorageURL: aud.StorageURL, MimeType: aud.MimeType, Duration: aud.Duration, SampleRate: aud.SampleRate, Channels: aud.Channels})
+		}
+	}
+
+	if ref.Index != AllItems {
+		if ref.Index >= len(inputs) {
+			return nil, fmt.Errorf("%s is out of range: step '%s' produced %d %s", ref, ref.Step, len(inputs), ref.Output)
+		}
+		inputs = inputs[ref.Index : ref.Index+1]
+	}
+	media := make([]interface{}, 0, len(inputs))
+	for _, input := range inputs {
+		m, err := toParams(input)
+		if err != nil {
+			return nil, err
+		}
+		media = append(media, m)
+	}
+	return media, nil
+}
+
+// toParams converts an input struct to the generic form tool params arrive in
+func toParams(v interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	err = json.Unmarshal(data, &m)
+	return m, err
+}
+
+// Simulator is an Executor that runs nothing: it validates each call, charges its
+// estimate and returns placeholder media of the tool's output type, one per output
+// unit. Use it to test planners and workflows without calling services.
+type Simulator struct {
+	Failures map[string]*errors.ServiceError // Step refs to fail, with the error to report
+}
+
+// Execute simulates one step
+func (s *Simulator) Execute(ctx context.Context, call messages.ToolCall, plan messages.PlanContext) (*results.ToolResult, error) {
+	if failure, fail := s.Failures[call.Ref]; fail {
+		return &results.ToolResult{Success: false, Tool: call.Name, Error: failure}, nil
+	}
+
+	decoded, err := tools.DecodeParams(call.Name, call.Parameters)
+	if err != nil {
+		return nil, tools.ValidationErrorToServiceError(err, call.Name, "")
+	}
+	name, _, _ := tools.ResolveToolName(call.Name)
+	estimate, err := pricing.EstimateCall(name, decoded)
+	if err != nil {
+		return nil, err
+	}
+	meta, _ := tools.GetToolMetadata(name)
+
+	now := time.Now()
+	result := &results.ToolResult{
+		Success: true,
+		Tool:    string(name),
+		Metadata: results.ExecutionMetadata{
+			StartTime:   now,
+			EndTime:     now,
+			CreatedAt:   now,
+			CreditsUsed: estimate.Credits,
+			Provider:    "simulator",
+			Model:       string(name),
+			RequestID:   plan.PlanID + "/" + call.Ref,
+		},
+	}
+	var images []types.OutputImage
+	var videos []types.OutputVideo
+	var audio []types.OutputAudio
+	for i := 0; i < estimate.Units; i++ {
+		id := fmt.Sprintf("%s-%s-%d", plan.PlanID, call.Ref, i)
+		url := fmt.Sprintf("gs://simulated/%s/%s/%d", plan.PlanID, call.Ref, i)
+		switch meta.OutputType {
+		case tools.OutputTypeImage:
+			images = append(images, types.OutputImage{ID: id, Index: i, StorageURL: url, MimeType: "image/png"})
+		case tools.OutputTypeVideo:
+			videos = append(videos, types.OutputVideo{ID: id, Index: i, StorageURL: url, MimeType: "video/mp4"})
+		case tools.OutputTypeAudio:
+			audio = append(audio, types.OutputAudio{ID: id, Index: i, StorageURL: url, MimeType: "audio/mpeg"})
+		}
+	}
+	if meta.ServiceType == tools.ServiceTypeVideoProcessor {
+		result.VideoProcessing = &results.VideoProcessingResult{
+			Images: images, Videos: videos, Audio: audio,
+			InputAssets: []results.InputReference{}, Operations: []results.ProcessingOperation{},
+		}
+	} else {
+		result.MediaGeneration = &results.MediaGenerationResult{
+			Images: images, Videos: videos, Audio: audio,
+			Model: string(name), TotalRequested: estimate.Units, TotalGenerated: estimate.Units,
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,60 @@
+package workflow
+
+import (
+	"context"
+	"testing"
+
+	"github.com/metaphi-labs/latent-contracts/errors"
+	"github.com/metaphi-labs/latent-contracts/progress"
+)
+
+func TestRunnerCancelsDependentsOfFailedStep(t *testing.T) {
+	w := &Workflow{ID: "wf", Steps: []Step{
+		imagenStep("draft", 2),
+		{Ref: "slideshow", Tool: "images-to-video", Params: map[string]interface{}{"images": "$draft.images[*]"}},
+		{Ref: "clip", Tool: "trim-video", Params: map[string]interface{}{"video": "$slideshow.videos[0]", "end_time": "2"}},
+		{Ref: "collage", Tool: "merge-images", Params: map[string]interface{}{"images": "$draft.images[*]"}},
+	}}
+	plan, err := Validate(w)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	runner := NewRunner(&Simulator{Failures: map[string]*errors.ServiceError{
+		"slideshow": errors.NewServiceError(errors.TOOL_EXECUTION_FAILED, "encoder crashed", "test", false),
+	}})
+	run, err := runner.Run(context.Background(), w)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]progress.Status{
+		"draft":     progress.StatusCompleted,
+		"slideshow": progress.StatusFailed,
+		"clip":      progress.StatusCancelled,
+		"collage":   progress.StatusCompleted,
+	}
+	for ref, status := range want {
+		step, _ := run.Step(ref)
+		if step.Status != status {
+			t.Errorf("%s: status %s, want %s", ref, step.Status, status)
+		}
+	}
+	if clip, _ := run.Step("clip"); clip.Error == nil || clip.Error.Code != errors.VAL_DEPENDENCY_MISSING {
+		t.Errorf("clip error = %v, want %s", clip.Error, errors.VAL_DEPENDENCY_MISSING)
+	}
+	if run.Succeeded() {
+		t.Error("run with a failed step reported success")
+	}
+
+	draft, _ := plan.Step("draft")
+	collage, _ := plan.Step("collage")
+	if wantCredits := draft.Estimate.Credits + collage.Estimate.Credits; run.Credits != wantCredits {
+		t.Errorf("credits = %d, want %d for the completed steps only", run.Credits, wantCredits)
+	}
+
+	collageRun, _ := run.Step("collage")
+	if images, _ := collageRun.Params["images"].([]interface{}); len(images) != 2 {
+		t.Errorf("collage ran with %d images, want the 2 draft images", len(images))
+	}
+}
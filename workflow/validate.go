@@ -0,0 +1,339 @@
+package workflow
+
+import (
+	"fmt"
+
+	"github.com/metaphi-labs/latent-contracts/errors"
+	"github.com/metaphi-labs/latent-contracts/pricing"
+	"github.com/metaphi-labs/latent-contracts/tools"
+)
+
+// PlannedStep is a validated step
+type PlannedStep struct {
+	Ref       string            `json:"ref"`
+	Tool      tools.ToolName    `json:"tool"` // Canonical name; aliases are resolved
+	DependsOn []string          `json:"depends_on,omitempty"`
+	Outputs   int               `json:"outputs"`            // Items the step is expected to produce
+	Estimate  *pricing.Estimate `json:"estimate,omitempty"` // Nil when the step's params are invalid
+}
+
+// Plan is a validated workflow in execution order
+type Plan struct {
+	WorkflowID string        `json:"workflow_id"`
+	Steps      []PlannedStep `json:"steps"`
+	Credits    int           `json:"credits"` // Sum of the step estimates
+}
+
+// Step returns the planned step with a ref
+func (p *Plan) Step(ref string) (PlannedStep, bool) {
+	for _, step := range p.Steps {
+		if step.Ref == ref {
+			return step, true
+		}
+	}
+	return PlannedStep{}, false
+}
+
+// Placeholder MIME types for the media a reference stands for during validation
+var placeholderMimeTypes = map[tools.OutputType]string{
+	tools.OutputTypeImage: "image/png",
+	tools.OutputTypeVideo: "video/mp4",
+	tools.OutputTypeAudio: "audio/mpeg",
+}
+
+// Validate checks a workflow without running it: step refs are unique, references
+// point at earlier steps' media of the kind the receiving param takes, there are no
+// cycles, and every step's params are valid once references are filled in.
+// Failures are returned as a VAL_INVALID_REQUEST ServiceError listing every problem,
+// with fields like "steps[2].params.images". When the steps can be ordered the plan
+// is returned alongside the error.
+func Validate(w *Workflow) (*Plan, error) {
+	v := &validator{w: w, index: make(map[string]int)}
+	v.checkSteps()
+	if len(v.details) > 0 {
+		return nil, v.err()
+	}
+	order, ok := v.order()
+	if !ok {
+		return nil, v.err()
+	}
+
+	plan := &Plan{WorkflowID: w.ID}
+	planned := make(map[string]PlannedStep)
+	for _, i := range order {
+		step := v.planStep(i, planned)
+		planned[step.Ref] = step
+		plan.Steps = append(plan.Steps, step)
+		if step.Estimate != nil {
+			plan.Credits += step.Estimate.Credits
+		}
+	}
+	return plan, v.err()
+}
+
+type validator struct {
+	w       *Workflow
+	index   map[string]int // Step ref -> position in w.Steps
+	tools   []tools.ToolName
+	details []errors.ValidationDetail
+}
+
+func (v *validator) fail(field string, code errors.ErrorCode, format string, args ...interface{}) {
+	v.details = append(v.details, errors.ValidationDetail{
+		Field:  field,
+		Reason: fmt.Sprintf(format, args...),
+		Code:   code,
+	})
+}
+
+func (v *validator) err() error {
+	if len(v.details) == 0 {
+		return nil
+	}
+	return errors.ValidationError("workflow", v.details)
+}
+
+// checkSteps validates refs, tool names and what each step depends on
+func (v *validator) checkSteps() {
+	if v.w.ID == "" {
+		v.fail("id", errors.VAL_MISSING_PARAMETER, "Workflow id is required")
+	}
+	if len(v.w.Steps) == 0 {
+		v.fail("steps", errors.VAL_ARRAY_TOO_SHORT, "Workflow must have at least one step")
+	}
+
+	for i, step := range v.w.Steps {
+		field := fmt.Sprintf("steps[%d].ref", i)
+		if !stepRefPattern.MatchString(step.Ref) {
+			v.fail(field, errors.VAL_INVALID_FORMAT, "Step ref %q must be non-empty and use only letters, digits, '_' and '-'", step.Ref)
+		} else if first, dup := v.index[step.Ref]; dup {
+			v.fail(field, errors.VAL_INVALID_PARAMETER, "Step ref '%s' is already used by steps[%d]", step.Ref, first)
+		} else {
+			v.index[step.Ref] = i
+		}
+
+		name, _, exists := tools.ResolveToolName(step.Tool)
+		if !exists {
+			v.fail(fmt.Sprintf("steps[%d].tool", i), errors.TOOL_NOT_FOUND, "Unknown tool '%s'", step.Tool)
+		}
+		v.tools = append(v.tools, name)
+	}
+
+	for i, step := range v.w.Steps {
+		for _, site := range findRefs(step.Params, "", false) {
+			field := fmt.Sprintf("steps[%d].params.%s", i, site.Path)
+			switch {
+			case site.Err != nil:
+				v.fail(field, errors.VAL_INVALID_FORMAT, "%s", site.Err)
+			case site.Ref.Step == step.Ref:
+				v.fail(field, errors.VAL_INVALID_PARAMETER, "Step '%s' references its own output", step.Ref)
+			default:
+				v.checkDependency(field, step.Ref, site.Ref.Step)
+			}
+		}
+		for j, dep := range step.DependsOn {
+			v.checkDependency(fmt.Sprintf("steps[%d].depends_on[%d]", i, j), step.Ref, dep)
+		}
+	}
+}
+
+func (v *validator) checkDependency(field, from, to string) {
+	if _, exists := v.index[to]; !exists {
+		v.fail(field, errors.VAL_DEPENDENCY_MISSING, "Step '%s' depends on unknown step '%s'", from, to)
+	}
+}
+
+// order sorts steps so each runs after its dependencies, keeping declaration order
+// among independent steps. A cycle is reported and ok is false.
+func (v *validator) order() (order []int, ok bool) {
+	deps := make([][]int, len(v.w.Steps))
+	for i, step := range v.w.Steps {
+		for _, dep := range step.dependencies() {
+			deps[i] = append(deps[i], v.index[dep])
+		}
+	}
+
+	done := make([]bool, len(v.w.Steps))
+	for len(order) < len(v.w.Steps) {
+		progressed := false
+		for i := range v.w.Steps {
+			if done[i] || !allDone(deps[i], done) {
+				continue
+			}
+			done[i] = true
+			order = append(order, i)
+			progressed = true
+		}
+		if !progressed {
+			cycle := findCycle(deps, done)
+			path := ""
+			for _, i := range cycle {
+				path += v.w.Steps[i].Ref + " -> "
+			}
+			path += v.w.Steps[cycle[0]].Ref
+			v.fail(fmt.Sprintf("steps[%d]", cycle[0]), errors.VAL_INVALID_REQUEST, "Steps form a cycle: %s", path)
+			return nil, false
+		}
+	}
+	return order, true
+}
+
+func allDone(deps []int, done []bool) bool {
+	for _, dep := range deps {
+		if !done[dep] {
+			return false
+		}
+	}
+	return true
+}
+
+// findCycle follows unfinished dependencies from the first unfinished step until
+// a step repeats, and returns the steps of the loop
+func findCycle(deps [][]int, done []bool) []int {
+	start := 0
+	for done[start] {
+		start++
+	}
+	seen := make(map[int]int) // Step -> position in path
+	var path []int
+	for current := start; ; {
+		if at, repeated := seen[current]; repeated {
+			return path[at:]
+		}
+		seen[current] = len(path)
+		path = append(path, current)
+		for _, dep := range deps[current] {
+			if !done[dep] {
+				current = dep
+				break
+			}
+		}
+	}
+}
+
+// planStep type-checks a step's references against the steps already planned,
+// validates its params with placeholder media and estimates its credits
+func (v *validator) planStep(i int, planned map[string]PlannedStep) PlannedStep {
+	step := v.w.Steps[i]
+	name := v.tools[i]
+	plannedStep := PlannedStep{Ref: step.Ref, Tool: name, DependsOn: step.dependencies()}
+
+	caps, _ := tools.GetCapabilities(name)
+	reported := len(v.details)
+	for _, site := range findRefs(step.Params, "", false) {
+		v.checkWiring(fmt.Sprintf("steps[%d].params.%s", i, site.Path), site, caps, planned[site.Ref.Step])
+	}
+
+	if len(v.details) > reported {
+		// Params decoding would only repeat the wiring errors
+		return plannedStep
+	}
+
+	params, err := resolveRefs(step.Params, func(ref Reference) ([]interface{}, error) {
+		outputs := planned[ref.Step].Outputs
+		if outputs == 0 {
+			outputs = 1 // The source's own params are invalid; assume one item to check the rest
+		}
+		return placeholderMedia(ref, outputs), nil
+	})
+	if err != nil {
+		// References were checked above; nothing left to report
+		return plannedStep
+	}
+	paramsMap, _ := params.(map[string]interface{})
+	decoded, err := tools.DecodeParams(string(name), paramsMap)
+	if err != nil {
+		v.failParams(i, name, err)
+		return plannedStep
+	}
+	estimate, err := pricing.EstimateCall(name, decoded)
+	if err != nil {
+		v.fail(fmt.Sprintf("steps[%d]", i), errors.VAL_INVALID_REQUEST, "%s", err)
+		return plannedStep
+	}
+	plannedStep.Estimate = estimate
+	plannedStep.Outputs = estimate.Units
+	return plannedStep
+}
+
+// checkWiring checks one reference: the source step produces that kind of media,
+// the receiving param takes it in that shape, and an index is within the outputs
+func (v *validator) checkWiring(field string, site refSite, caps tools.Capabilities, source PlannedStep) {
+	ref := site.Ref
+	kind := ref.Output.Kind()
+
+	if meta, exists := tools.GetToolMetadata(source.Tool); exists && meta.OutputType != kind {
+		v.fail(field, errors.VAL_INVALID_PARAMETER,
+			"Step '%s' (%s) produces %s, not %s", source.Ref, source.Tool, meta.OutputType, ref.Output)
+		return
+	}
+
+	input, found := mediaInput(caps, site.Field())
+	if !found {
+		switch {
+		case ref.Index != AllItems && !site.InList && hasMediaInput(caps, site.Field()+"[]"):
+			v.fail(field, errors.VAL_INVALID_PARAMETER,
+				"Parameter '%s' takes a list; use %s or put the reference in a list", site.Path, Reference{ref.Step, ref.Output, AllItems})
+		case ref.Index == AllItems && !site.InList && hasMediaInput(caps, site.Path):
+			v.fail(field, errors.VAL_INVALID_PARAMETER,
+				"Parameter '%s' takes a single item; use an index such as %s", site.Path, Reference{ref.Step, ref.Output, 0})
+		default:
+			v.fail(field, errors.VAL_INVALID_PARAMETER, "Parameter '%s' of %s does not take media", site.Path, caps.Tool)
+		}
+		return
+	}
+	if input.Kind != kind {
+		v.fail(field, errors.VAL_INVALID_PARAMETER,
+			"Parameter '%s' takes %s, but %s is %s", site.Path, input.Kind, ref, kind)
+		return
+	}
+	if source.Estimate != nil && ref.Index != AllItems && ref.Index >= source.Outputs {
+		v.fail(field, errors.VAL_OUT_OF_RANGE,
+			"Step '%s' produces %d %s; %s is out of range", source.Ref, source.Outputs, ref.Output, ref)
+	}
+}
+
+// failParams records a step's params errors with fields prefixed by the step
+func (v *validator) failParams(i int, name tools.ToolName, err error) {
+	prefix := fmt.Sprintf("steps[%d].params.", i)
+	serviceErr := tools.ValidationErrorToServiceError(err, string(name), "")
+	if serviceErr.HasValidationErrors() {
+		for _, detail := range serviceErr.Metadata.ValidationDetails {
+			detail.Field = prefix + detail.Field
+			v.details = append(v.details, detail)
+		}
+		return
+	}
+	v.fail(fmt.Sprintf("steps[%d].params", i), errors.VAL_INVALID_REQUEST, "%s", err)
+}
+
+func mediaInput(caps tools.Capabilities, field string) (tools.MediaInput, bool) {
+	for _, input := range caps.Inputs {
+		if input.Field == field {
+			return input, true
+		}
+	}
+	return tools.MediaInput{}, false
+}
+
+func hasMediaInput(caps tools.Capabilities, field string) bool {
+	_, found := mediaInput(caps, field)
+	return found
+}
+
+// placeholderMedia stands in for the media a reference will resolve to at run time
+func placeholderMedia(ref Reference, outputs int) []interface{} {
+	first, count := ref.Index, 1
+	if ref.Index == AllItems {
+		first, count = 0, outputs
+	}
+	media := make([]interface{}, 0, count)
+	for i := first; i < first+count; i++ {
+		media = append(media, map[string]interface{}{
+			"storage_url":     fmt.Sprintf("gs://workflow/%s/%s/%d", ref.Step, ref.Output, i),
+			"mime_type":       placeholderMimeTypes[ref.Output.Kind()],
+			"file_size_bytes": 1,
+		})
+	}
+	return media
+}
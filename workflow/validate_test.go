@@ -0,0 +1,116 @@
+package workflow
+
+import (
+	stderrors "errors"
+	"strings"
+	"testing"
+
+	"github.com/metaphi-labs/latent-contracts/errors"
+)
+
+// validationDetails validates a workflow that must fail and returns the details by field
+func validationDetails(t *testing.T, w *Workflow) map[string]errors.ValidationDetail {
+	t.Helper()
+	_, err := Validate(w)
+	var serviceErr *errors.ServiceError
+	if !stderrors.As(err, &serviceErr) || !serviceErr.HasValidationErrors() {
+		t.Fatalf("Validate error = %v, want validation details", err)
+	}
+	details := make(map[string]errors.ValidationDetail)
+	for _, detail := range serviceErr.Metadata.ValidationDetails {
+		details[detail.Field] = detail
+	}
+	return details
+}
+
+// expectDetail fails unless a detail for field has the code and mentions the text
+func expectDetail(t *testing.T, details map[string]errors.ValidationDetail, field string, code errors.ErrorCode, text string) {
+	t.Helper()
+	detail, exists := details[field]
+	if !exists {
+		t.Fatalf("no detail for %s; got %+v", field, details)
+	}
+	if detail.Code != code || !strings.Contains(detail.Reason, text) {
+		t.Errorf("%s: %s %q, want %s mentioning %q", field, detail.Code, detail.Reason, code, text)
+	}
+}
+
+func imagenStep(ref string, images int) Step {
+	return Step{Ref: ref, Tool: "generate-image-imagen", Params: map[string]interface{}{
+		"prompt":           "a lighthouse at dusk",
+		"number_of_images": images,
+	}}
+}
+
+func TestValidateReportsCycle(t *testing.T) {
+	a, b := imagenStep("a", 1), imagenStep("b", 1)
+	a.DependsOn, b.DependsOn = []string{"b"}, []string{"a"}
+
+	details := validationDetails(t, &Workflow{ID: "wf", Steps: []Step{a, b}})
+	expectDetail(t, details, "steps[0]", errors.VAL_INVALID_REQUEST, "Steps form a cycle: a -> b -> a")
+}
+
+func TestValidateReportsUnknownDependency(t *testing.T) {
+	a := imagenStep("a", 1)
+	a.DependsOn = []string{"missing"}
+
+	details := validationDetails(t, &Workflow{ID: "wf", Steps: []Step{a}})
+	expectDetail(t, details, "steps[0].depends_on[0]", errors.VAL_DEPENDENCY_MISSING, "unknown step 'missing'")
+}
+
+func TestValidateReportsKindMismatch(t *testing.T) {
+	details := validationDetails(t, &Workflow{ID: "wf", Steps: []Step{
+		{Ref: "a", Tool: "generate-video-veo3-fast", Params: map[string]interface{}{"prompt": "waves against a pier"}},
+		{Ref: "b", Tool: "images-to-video", Params: map[string]interface{}{"images": "$a.videos[*]"}},
+	}})
+	expectDetail(t, details, "steps[1].params.images", errors.VAL_INVALID_PARAMETER, "takes image")
+}
+
+func TestValidateReportsListShapeMismatch(t *testing.T) {
+	details := validationDetails(t, &Workflow{ID: "wf", Steps: []Step{
+		imagenStep("a", 2),
+		{Ref: "b", Tool: "images-to-video", Params: map[string]interface{}{"images": "$a.images[0]"}},
+		{Ref: "c", Tool: "generate-video-veo3-fast", Params: map[string]interface{}{"prompt": "waves against a pier"}},
+		{Ref: "d", Tool: "trim-video", Params: map[string]interface{}{"video": "$c.videos[*]", "end_time": "2"}},
+	}})
+	expectDetail(t, details, "steps[1].params.images", errors.VAL_INVALID_PARAMETER, "takes a list")
+	expectDetail(t, details, "steps[3].params.video", errors.VAL_INVALID_PARAMETER, "takes a single item")
+}
+
+func TestValidateReportsIndexOutOfRange(t *testing.T) {
+	details := validationDetails(t, &Workflow{ID: "wf", Steps: []Step{
+		imagenStep("a", 2),
+		{Ref: "b", Tool: "images-to-video", Params: map[string]interface{}{"images": []interface{}{"$a.images[3]"}}},
+	}})
+	expectDetail(t, details, "steps[1].params.images[0]", errors.VAL_OUT_OF_RANGE, "produces 2 images")
+}
+
+func TestValidateKeepsParamDetails(t *testing.T) {
+	details := validationDetails(t, &Workflow{ID: "wf", Steps: []Step{
+		imagenStep("a", 2),
+		{Ref: "b", Tool: "images-to-video", Params: map[string]interface{}{"images": "$a.images[*]", "transition": "fdae"}},
+	}})
+	expectDetail(t, details, "steps[1].params.transition", errors.VAL_INVALID_ENUM, "must be one of")
+	if got := details["steps[1].params.transition"].Suggestion; got != "fade" {
+		t.Errorf("suggestion = %q, want fade", got)
+	}
+}
+
+func TestValidatePlansValidWorkflow(t *testing.T) {
+	plan, err := Validate(&Workflow{ID: "wf", Steps: []Step{
+		{Ref: "b", Tool: "images-to-video", Params: map[string]interface{}{"images": "$a.images[*]"}},
+		imagenStep("a", 3),
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan.Steps) != 2 || plan.Steps[0].Ref != "a" || plan.Steps[1].Ref != "b" {
+		t.Fatalf("plan order = %+v, want a then b", plan.Steps)
+	}
+	if plan.Steps[0].Outputs != 3 {
+		t.Errorf("a outputs = %d, want 3", plan.Steps[0].Outputs)
+	}
+	if want := plan.Steps[0].Estimate.Credits + plan.Steps[1].Estimate.Credits; plan.Credits != want {
+		t.Errorf("plan credits = %d, want %d", plan.Credits, want)
+	}
+}
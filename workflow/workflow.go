@@ -0,0 +1,236 @@
+// Package workflow defines multi-step tool pipelines. Each step is a tool call whose
+// params may reference the media produced by earlier steps, e.g.
+//
+//	{"ref": "slideshow", "tool": "images-to-video", "params": {"images": "$draft.images[*]"}}
+//
+// Validate checks a workflow statically against tool metadata (wiring types, cycles,
+// params) and estimates its credits; Runner executes it through an Executor.
+package workflow
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/metaphi-labs/latent-contracts/tools"
+)
+
+// Workflow is a set of tool calls wired output to input
+type Workflow struct {
+	ID    string `json:"id"`    // Becomes messages.PlanContext.PlanID when run
+	Steps []Step `json:"steps"` // In any order; execution order follows references
+}
+
+// Step is one tool call in a workflow
+type Step struct {
+	Ref       string                 `json:"ref"` // Unique within the workflow, e.g. "step1"
+	Tool      string                 `json:"tool"`
+	Params    map[string]interface{} `json:"params,omitempty"`     // String values like "$step1.images[*]" reference earlier outputs
+	DependsOn []string               `json:"depends_on,omitempty"` // Extra ordering without passing data
+}
+
+// Output names a media list of a step's result
+type Output string
+
+const (
+	OutputImages Output = "images"
+	OutputVideos Output = "videos"
+	OutputAudio  Output = "audio"
+)
+
+// Kind returns the tools.OutputType an output holds
+func (o Output) Kind() tools.OutputType {
+	switch o {
+	case OutputImages:
+		return tools.OutputTypeImage
+	case OutputVideos:
+		return tools.OutputTypeVideo
+	case OutputAudio:
+		return tools.OutputTypeAudio
+	}
+	return ""
+}
+
+// AllItems is the Reference.Index of a [*] reference
+const AllItems = -1
+
+// Reference points at the output of another step: "$<ref>.<output>[<n>|*]"
+type Reference struct {
+	Step   string
+	Output Output
+	Index  int // 0-based, or AllItems
+}
+
+func (r Reference) String() string {
+	index := "*"
+	if r.Index != AllItems {
+		index = strconv.Itoa(r.Index)
+	}
+	return fmt.Sprintf("$%s.%s[%s]", r.Step, r.Output, index)
+}
+
+var (
+	stepRefPattern   = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+	referencePattern = regexp.MustCompile(`^\$([A-Za-z0-9_-]+)\.([a-z]+)\[(\*|\d+)\]$`)
+	// Strings shaped like a reference, valid or not; "$5.99" or "$5 off" don't match
+	referenceShape = regexp.MustCompile(`^\$[A-Za-z0-9_-]+\.[A-Za-z]+(\[[^\]]*\])?$`)
+)
+
+// ParseReference parses a reference like "$step1.images[*]" or "$clip.videos[0]"
+func ParseReference(s string) (Reference, error) {
+	m := referencePattern.FindStringSubmatch(s)
+	if m == nil {
+		return Reference{}, fmt.Errorf("invalid reference %q: expected $<step>.<images|videos|audio>[<n>|*]", s)
+	}
+	ref := Reference{Step: m[1], Output: Output(m[2]), Index: AllItems}
+	if ref.Output.Kind() == "" {
+		return Reference{}, fmt.Errorf("invalid reference %q: output must be images, videos or audio", s)
+	}
+	if m[3] != "*" {
+		ref.Index, _ = strconv.Atoi(m[3])
+	}
+	return ref, nil
+}
+
+// refSite is a reference found in a step's params
+type refSite struct {
+	Path   string // JSON path of the value, e.g. "images" or "videos[1]"
+	InList bool   // The value is an item of a list
+	Ref    Reference
+	Err    error // Set when the string looks like a reference but doesn't parse
+}
+
+// Field returns the params field the reference fills, in tools.MediaInput.Field form
+func (s refSite) Field() string {
+	field := listIndexPattern.ReplaceAllString(s.Path, "[]")
+	if !s.InList && s.Ref.Index == AllItems {
+		field += "[]"
+	}
+	return field
+}
+
+var listIndexPattern = regexp.MustCompile(`\[\d+\]`)
+
+// findRefs walks params for strings shaped like references.
+// Other strings starting with "$" are left alone so text like "$5 off" stays literal.
+func findRefs(value interface{}, path string, inList bool) []refSite {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		var sites []refSite
+		for _, key := range sortedKeys(v) {
+			sites = append(sites, findRefs(v[key], joinPath(path, key), false)...)
+		}
+		return sites
+	case []interface{}:
+		var sites []refSite
+		for i, item := range v {
+			sites = append(sites, findRefs(item, fmt.Sprintf("%s[%d]", path, i), true)...)
+		}
+		return sites
+	case string:
+		if !referenceShape.MatchString(v) {
+			return nil
+		}
+		ref, err := ParseReference(v)
+		return []refSite{{Path: path, InList: inList, Ref: ref, Err: err}}
+	}
+	return nil
+}
+
+// resolveRefs returns a copy of params with every reference replaced by the media
+// resolve returns for it. [*] references inside a list are spliced into it.
+func resolveRefs(value interface{}, resolve func(Reference) ([]interface{}, error)) (interface{}, error) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			resolved, err := resolveRefs(item, resolve)
+			if err != nil {
+				return nil, err
+			}
+			out[key] = resolved
+		}
+		return out, nil
+	case []interface{}:
+		out := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok && referenceShape.MatchString(s) {
+				media, err := resolveString(s, resolve)
+				if err != nil {
+					return nil, err
+				}
+				out = append(out, media...)
+				continue
+			}
+			resolved, err := resolveRefs(item, resolve)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, resolved)
+		}
+		return out, nil
+	case string:
+		if !referenceShape.MatchString(v) {
+			return v, nil
+		}
+		ref, err := ParseReference(v)
+		if err != nil {
+			return nil, err
+		}
+		media, err := resolve(ref)
+		if err != nil {
+			return nil, err
+		}
+		if ref.Index == AllItems {
+			return media, nil
+		}
+		return media[0], nil
+	}
+	return value, nil
+}
+
+func resolveString(s string, resolve func(Reference) ([]interface{}, error)) ([]interface{}, error) {
+	ref, err := ParseReference(s)
+	if err != nil {
+		return nil, err
+	}
+	return resolve(ref)
+}
+
+// dependencies returns the steps a step reads from or explicitly depends on, without duplicates
+func (s Step) dependencies() []string {
+	seen := make(map[string]bool)
+	var deps []string
+	add := func(ref string) {
+		if !seen[ref] {
+			seen[ref] = true
+			deps = append(deps, ref)
+		}
+	}
+	for _, site := range findRefs(s.Params, "", false) {
+		if site.Err == nil {
+			add(site.Ref.Step)
+		}
+	}
+	for _, ref := range s.DependsOn {
+		add(ref)
+	}
+	return deps
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}